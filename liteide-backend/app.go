@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
+	"liteide-backend/config"
 	"liteide-backend/repository/db"
 	"liteide-backend/router"
+	"liteide-backend/service"
 	"liteide-backend/svc"
 	"os"
 	"os/signal"
@@ -17,36 +19,64 @@ import (
 var ApiServer *fiber.App // 声明一个全局变量，用于存储 Fiber 应用实例
 
 // startApiServer 启动 API 服务器
-func startApiServer() {
+// - `conf`：应用配置
+// - `services`：路由使用的服务
+func startApiServer(conf config.AppConfig, services *service.Services) {
 	// 创建一个新的 Fiber 应用，并配置自定义的错误处理函数
 	ApiServer = fiber.New(fiber.Config{
 		ErrorHandler: router.ErrorHandler, // 自定义错误处理函数
 	})
 
 	// 使用自定义的路由配置
-	router.UseRouter(ApiServer)
+	router.UseRouter(ApiServer, conf, services)
 
 	// 输出服务器启动的日志，显示监听的端口号
-	log.Infof("Web Server listening at %v", svc.SVC.AppConfig.ApiConfig.Port)
+	log.Infof("Web Server listening at %v", conf.ApiConfig.Port)
 
 	// 启动服务器并监听指定的端口号，若出错则输出日志并终止程序
-	if err := ApiServer.Listen(fmt.Sprintf(":%d", svc.SVC.AppConfig.ApiConfig.Port)); err != nil {
+	if err := ApiServer.Listen(fmt.Sprintf(":%d", conf.ApiConfig.Port)); err != nil {
 		log.Panic(err) // 如果服务器启动失败，输出错误并终止程序
 	}
 }
 
 func main() {
-	// 初始化服务上下文，可能用于加载配置等初始化操作
-	svc.NewServiceContext()
+	// 初始化服务上下文，加载配置并创建数据库客户端与容器运行时
+	serviceContext := svc.NewServiceContext()
 
 	// 执行数据库迁移操作，确保数据库结构与应用一致
-	db.Migrate(svc.SVC.AppConfig)
+	db.Migrate(serviceContext.Database)
+
+	// 创建服务，路由与后台协程共享同一组服务
+	services := service.NewServices(serviceContext)
+
+	// 创建初始管理员（配置了 ADMIN_PASSWORD 时）
+	if err := services.Auth.EnsureAdmin(context.Background()); err != nil {
+		log.Fatalf("failed to create admin user: %v", err)
+	}
+	// 将引入用户之前创建的工作区与容器归属给管理员
+	if err := services.Auth.AssignOwnerlessRecords(context.Background()); err != nil {
+		log.Fatalf("failed to assign records without owner: %v", err)
+	}
+
+	// 为语言工具链中尚未配置镜像的语言创建镜像记录
+	if err := services.Images.SyncLanguageImages(context.Background()); err != nil {
+		log.Fatalf("failed to sync language images: %v", err)
+	}
+
+	// 启动容器记录与容器运行时实例的后台对账协程
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go services.Containers.StartReconciler(backgroundCtx)
+	// 启动空闲容器自动删除协程
+	go services.Containers.StartIdleReaper(backgroundCtx)
+	// 启动镜像预拉取协程
+	go services.Images.StartImagePuller(backgroundCtx)
 
 	// 使用 goroutine 异步启动 API 服务器
-	go startApiServer()
+	go startApiServer(serviceContext.AppConfig, services)
 
 	// 创建一个信号通道，用于接收操作系统发送的信号（如关闭信号）
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	// 注册接收 SIGINT (Ctrl+C) 和 SIGTERM (终止信号)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
@@ -56,6 +86,9 @@ func main() {
 	// 收到退出信号后，记录关闭服务器的日志
 	log.Info("Shutdown Server ...")
 
+	// 停止后台对账与空闲检查
+	stopBackground()
+
 	// 创建一个带有 2 秒超时的上下文，用于优雅关闭服务器
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel() // 在函数退出时，确保调用 cancel() 释放资源
@@ -1,26 +1,125 @@
 package config
 
-import "liteide-backend/repository/utils" // 引入工具包，用于解析环境变量
+import (
+	"errors"
+	"fmt"
+	"liteide-backend/ent/property"     // 引入 ent 枚举类型，用于网络隔离策略
+	"liteide-backend/repository/utils" // 引入工具包，用于解析环境变量
+	"slices"
+	"time"
+)
 
 // ApiConfig 结构体定义 API 相关配置
 type ApiConfig struct {
 	Port int // API 监听端口
 }
 
-// MySQLConfig 结构体定义 MySQL 数据库的连接配置
-type MySQLConfig struct {
-	Username string // MySQL 用户名
-	Password string // MySQL 密码
-	Address  string // MySQL 服务器地址 (host:port)
-	Database string // MySQL 数据库名称
+// 数据库驱动，与 ent 的方言名称一致
+const (
+	DatabaseMySQL    = "mysql"
+	DatabaseSQLite   = "sqlite3"
+	DatabasePostgres = "postgres"
+)
+
+// SQLiteMemory SQLite 使用内存数据库时的数据库名称，数据在进程退出后丢失
+const SQLiteMemory = ":memory:"
+
+// DatabaseConfig 结构体定义数据库的连接配置
+type DatabaseConfig struct {
+	Driver   string // 数据库驱动：mysql、sqlite3 或 postgres
+	Username string // 数据库用户名（SQLite 不使用）
+	Password string // 数据库密码（SQLite 不使用）
+	Address  string // 数据库服务器地址 (host:port)（SQLite 不使用）
+	Database string // 数据库名称；SQLite 下为数据库文件路径，:memory: 表示内存数据库
+}
+
+// AuthConfig 结构体定义身份认证配置
+type AuthConfig struct {
+	JWTSecret     string        // 签发访问令牌的 HMAC 密钥，为空时每次启动随机生成
+	TokenTTL      time.Duration // 访问令牌有效期
+	AdminUsername string        // 初始管理员用户名
+	AdminPassword string        // 初始管理员密码，为空时不创建初始管理员
+}
+
+// QuotaConfig 结构体定义每个用户的配额，0 表示不限制
+type QuotaConfig struct {
+	MaxContainers int   // 同时处于 Pending 或 Up 状态的容器数上限
+	MaxWorkspaces int   // 工作区总数上限
+	MaxDiskBytes  int64 // 所有工作区目录占用的磁盘空间上限（字节）
+}
+
+// ResourceConfig 结构体定义容器的默认资源限制与预留
+type ResourceConfig struct {
+	NanoCPUs           int64 // CPU 上限（单位：1e-9 核）
+	MemoryBytes        int64 // 内存上限（字节）
+	PidsLimit          int64 // 进程数上限
+	NanoCPUReservation int64 // CPU 预留（单位：1e-9 核）
+	MemoryReservation  int64 // 内存预留（字节）
+}
+
+// Validate 校验资源配置：上限必须为正数，预留不能为负数且不能超过上限
+func (c ResourceConfig) Validate() error {
+	if c.NanoCPUs <= 0 || c.MemoryBytes <= 0 || c.PidsLimit <= 0 {
+		return errors.New("resource limits must be positive")
+	}
+	if c.NanoCPUReservation < 0 || c.MemoryReservation < 0 {
+		return errors.New("resource reservations must not be negative")
+	}
+	if c.NanoCPUReservation > c.NanoCPUs || c.MemoryReservation > c.MemoryBytes {
+		return errors.New("resource reservations must not exceed limits")
+	}
+	return nil
+}
+
+// NetworkConfig 结构体定义容器的网络隔离配置
+type NetworkConfig struct {
+	DefaultMode     property.NetworkMode // 默认网络隔离策略
+	InternalNetwork string               // INTERNAL 策略使用的内部网络名称（Swarm 下为 overlay，单机 Docker 下为 bridge）
+	EgressNetwork   string               // EGRESS 策略使用的可出站网络名称
+}
+
+// Validate 校验网络配置
+func (c NetworkConfig) Validate() error {
+	if !slices.Contains(c.DefaultMode.Values(), string(c.DefaultMode)) {
+		return fmt.Errorf("unknown network mode: %s", c.DefaultMode)
+	}
+	if c.InternalNetwork == "" || c.EgressNetwork == "" {
+		return errors.New("network names must not be empty")
+	}
+	return nil
+}
+
+// KubernetesConfig 结构体定义 Kubernetes 运行时配置
+type KubernetesConfig struct {
+	Kubeconfig     string // kubeconfig 文件路径，为空时使用集群内配置（ServiceAccount）
+	Namespace      string // 创建 Pod 的命名空间
+	WorkspaceClaim string // 挂载工作区的 PVC 名称，需与后端的数据目录共享同一存储；为空时使用 hostPath
+	PauseImage     string // 预拉取镜像时 DaemonSet 使用的占位镜像
 }
 
 // AppConfig 结构体定义整个应用的配置信息
 type AppConfig struct {
-	ApiConfig              ApiConfig   // API 配置
-	MySQLConfig            MySQLConfig // MySQL 连接配置
-	ContainerServicePrefix string      // 容器服务前缀（用于 Swarm 容器命名）
-	DataDirectory          string      // 应用数据存储目录
+	ApiConfig              ApiConfig        // API 配置
+	DatabaseConfig         DatabaseConfig   // 数据库连接配置
+	AuthConfig             AuthConfig       // 身份认证配置
+	QuotaConfig            QuotaConfig      // 用户配额配置
+	ResourceConfig         ResourceConfig   // 容器默认资源配置
+	NetworkConfig          NetworkConfig    // 容器网络隔离配置
+	KubernetesConfig       KubernetesConfig // Kubernetes 运行时配置
+	ContainerRuntime       string           // 容器运行时：swarm、docker、kubernetes 或 fake
+	AllowFakeRuntime       bool             // 是否允许使用 fake 运行时（直接在本机执行命令，仅限开发环境）
+	ContainerServicePrefix string           // 容器实例前缀（用于 Swarm 服务或 Docker 容器命名）
+	ImagePullServicePrefix string           // 镜像预拉取服务前缀（用于 Swarm 任务命名）
+	ImagePullInterval      time.Duration    // 周期性预拉取全部镜像的间隔，0 表示只在启动和镜像变更时拉取
+	ImagePullTimeout       time.Duration    // 单个镜像预拉取的超时时间
+	ContainerStartTimeout  time.Duration    // 等待容器实例启动的超时时间
+	ReconcileInterval      time.Duration    // 容器记录与运行时实例对账的间隔
+	IdleTimeout            time.Duration    // 容器空闲多久后自动删除，0 表示不自动删除
+	IdleWarning            time.Duration    // 自动删除前多久向已附加的终端发送预警
+	RunTimeout             time.Duration    // 非交互运行程序的默认超时时间
+	RunMaxTimeout          time.Duration    // 非交互运行程序允许的最大超时时间
+	LanguageFile           string           // 语言工具链配置文件路径
+	DataDirectory          string           // 应用数据存储目录
 }
 
 // NewConfig 创建并返回应用的默认配置
@@ -31,14 +130,69 @@ func NewConfig() AppConfig {
 		ApiConfig: ApiConfig{
 			Port: utils.ParseEnvConfig("WEB_PORT", 8080),
 		},
-		// 解析 MySQL 连接参数
-		MySQLConfig: MySQLConfig{
-			Username: utils.ParseEnvConfig("MYSQL_USERNAME", "root"),       // 解析 MySQL 用户名，默认 root
-			Password: utils.ParseEnvConfig("MYSQL_PASSWORD", "123456"),     // 解析 MySQL 密码，默认 123456
-			Address:  utils.ParseEnvConfig("MYSQL_ADDR", "localhost:3306"), // 解析 MySQL 地址，默认 localhost:3306
-			Database: utils.ParseEnvConfig("MYSQL_DATABASE", "liteide"),    // 解析 MySQL 数据库名称，默认 liteide
+		// 解析数据库连接参数，默认连接 MySQL；未设置 DB_* 时兼容原有的 MYSQL_* 环境变量
+		DatabaseConfig: DatabaseConfig{
+			Driver:   utils.ParseEnvConfig("DB_DRIVER", DatabaseMySQL),
+			Username: utils.ParseEnvConfig("DB_USERNAME", utils.ParseEnvConfig("MYSQL_USERNAME", "root")),    // 默认 root
+			Password: utils.ParseEnvConfig("DB_PASSWORD", utils.ParseEnvConfig("MYSQL_PASSWORD", "123456")),  // 默认 123456
+			Address:  utils.ParseEnvConfig("DB_ADDR", utils.ParseEnvConfig("MYSQL_ADDR", "localhost:3306")),  // 默认 localhost:3306
+			Database: utils.ParseEnvConfig("DB_DATABASE", utils.ParseEnvConfig("MYSQL_DATABASE", "liteide")), // 默认 liteide
+		},
+		// 解析身份认证配置，令牌有效期以秒为单位，默认 24 小时
+		AuthConfig: AuthConfig{
+			JWTSecret:     utils.ParseEnvConfig("JWT_SECRET", ""),
+			TokenTTL:      time.Duration(utils.ParseEnvConfig("TOKEN_TTL", 86400)) * time.Second,
+			AdminUsername: utils.ParseEnvConfig("ADMIN_USERNAME", "admin"),
+			AdminPassword: utils.ParseEnvConfig("ADMIN_PASSWORD", ""),
+		},
+		// 解析用户配额，磁盘空间以 MiB 为单位
+		QuotaConfig: QuotaConfig{
+			MaxContainers: utils.ParseEnvConfig("USER_MAX_CONTAINERS", 2),          // 默认同时运行 2 个容器
+			MaxWorkspaces: utils.ParseEnvConfig("USER_MAX_WORKSPACES", 20),         // 默认最多 20 个工作区
+			MaxDiskBytes:  int64(utils.ParseEnvConfig("USER_MAX_DISK", 200)) << 20, // 默认最多 200 MiB
+		},
+		// 解析容器默认资源配置，CPU 以千分之一核、内存以 MiB 为单位
+		ResourceConfig: ResourceConfig{
+			NanoCPUs:           int64(utils.ParseEnvConfig("CONTAINER_CPU_LIMIT", 1000)) * 1e6,        // CPU 上限，默认 1 核
+			MemoryBytes:        int64(utils.ParseEnvConfig("CONTAINER_MEMORY_LIMIT", 512)) << 20,      // 内存上限，默认 512 MiB
+			PidsLimit:          int64(utils.ParseEnvConfig("CONTAINER_PIDS_LIMIT", 256)),              // 进程数上限，默认 256
+			NanoCPUReservation: int64(utils.ParseEnvConfig("CONTAINER_CPU_RESERVATION", 100)) * 1e6,   // CPU 预留，默认 0.1 核
+			MemoryReservation:  int64(utils.ParseEnvConfig("CONTAINER_MEMORY_RESERVATION", 64)) << 20, // 内存预留，默认 64 MiB
+		},
+		// 解析容器网络配置，默认不允许出站访问
+		NetworkConfig: NetworkConfig{
+			DefaultMode:     property.NetworkMode(utils.ParseEnvConfig("CONTAINER_NETWORK_MODE", string(property.NetworkModeNone))),
+			InternalNetwork: utils.ParseEnvConfig("CONTAINER_INTERNAL_NETWORK", "liteide-internal"),
+			EgressNetwork:   utils.ParseEnvConfig("CONTAINER_EGRESS_NETWORK", "liteide-egress"),
+		},
+		// 解析 Kubernetes 运行时配置
+		KubernetesConfig: KubernetesConfig{
+			Kubeconfig:     utils.ParseEnvConfig("KUBECONFIG", ""),
+			Namespace:      utils.ParseEnvConfig("K8S_NAMESPACE", "liteide"),
+			WorkspaceClaim: utils.ParseEnvConfig("K8S_WORKSPACE_CLAIM", ""),
+			PauseImage:     utils.ParseEnvConfig("K8S_PAUSE_IMAGE", "registry.k8s.io/pause:3.10"),
 		},
-		ContainerServicePrefix: "liteide-pod-",                                  // Swarm 容器服务的命名前缀
-		DataDirectory:          "D:/Proj/ezcoding/liteide/liteide-backend/data", // 存储数据的本地目录
+		// 解析容器运行时，默认使用 Docker Swarm；未初始化 Swarm 的开发环境可使用 docker，离线开发可使用 fake（需同时设置 ALLOW_FAKE_RUNTIME）
+		ContainerRuntime:       utils.ParseEnvConfig("CONTAINER_RUNTIME", "swarm"),
+		AllowFakeRuntime:       utils.ParseEnvConfig("ALLOW_FAKE_RUNTIME", false), // fake 运行时没有任何隔离，必须显式开启
+		ContainerServicePrefix: "liteide-pod-",                                    // 容器实例的命名前缀
+		ImagePullServicePrefix: "liteide-pull-",                                   // Swarm 镜像预拉取服务的命名前缀
+		// 解析镜像预拉取间隔（秒），默认 6 小时
+		ImagePullInterval: time.Duration(utils.ParseEnvConfig("IMAGE_PULL_INTERVAL", 21600)) * time.Second,
+		// 解析镜像预拉取超时（秒），默认 10 分钟
+		ImagePullTimeout: time.Duration(utils.ParseEnvConfig("IMAGE_PULL_TIMEOUT", 600)) * time.Second,
+		// 解析容器启动超时时间（秒），默认 120 秒（包含拉取镜像的时间）
+		ContainerStartTimeout: time.Duration(utils.ParseEnvConfig("CONTAINER_START_TIMEOUT", 120)) * time.Second,
+		// 解析对账间隔（秒），默认 60 秒
+		ReconcileInterval: time.Duration(utils.ParseEnvConfig("RECONCILE_INTERVAL", 60)) * time.Second,
+		// 解析容器空闲超时（秒），默认 30 分钟
+		IdleTimeout: time.Duration(utils.ParseEnvConfig("CONTAINER_IDLE_TIMEOUT", 1800)) * time.Second,
+		// 解析空闲预警提前量（秒），默认 60 秒
+		IdleWarning: time.Duration(utils.ParseEnvConfig("CONTAINER_IDLE_WARNING", 60)) * time.Second,
+		// 解析程序运行超时（秒），默认 10 秒，最大 60 秒
+		RunTimeout:    time.Duration(utils.ParseEnvConfig("RUN_TIMEOUT", 10)) * time.Second,
+		RunMaxTimeout: time.Duration(utils.ParseEnvConfig("RUN_MAX_TIMEOUT", 60)) * time.Second,
+		LanguageFile:  utils.ParseEnvConfig("LANGUAGE_FILE", "config/languages.json"), // 语言工具链配置文件，不存在时使用内置配置
+		DataDirectory: "D:/Proj/ezcoding/liteide/liteide-backend/data",                // 存储数据的本地目录
 	}
 }
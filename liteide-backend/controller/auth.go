@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"github.com/gofiber/contrib/websocket"      // 引入 Fiber WebSocket 库
+	"github.com/gofiber/fiber/v2"               // 引入 Fiber Web 框架
+	"liteide-backend/config"                    // 引入配额配置
+	"liteide-backend/controller/internal/model" // 引入请求/响应 DTO
+	"liteide-backend/ent"                       // 引入 ent ORM 生成的实体
+	"liteide-backend/service"                   // 引入认证服务层
+)
+
+// AuthController 登录、当前用户与用户管理相关的请求处理
+type AuthController struct {
+	auth       *service.AuthService      // 认证服务
+	workspaces *service.WorkspaceService // 工作区服务，用于统计资源占用
+	quota      config.QuotaConfig        // 配额配置，随资源占用一起返回
+}
+
+// NewAuthController 创建认证控制器
+func NewAuthController(auth *service.AuthService, workspaces *service.WorkspaceService, quota config.QuotaConfig) *AuthController {
+	return &AuthController{auth: auth, workspaces: workspaces, quota: quota}
+}
+
+// Login 处理登录请求
+// - POST /auth/login
+// - Body：{"username": "alice", "password": "secret123"}
+// - 返回：{"token": "...", "expire_time": "...", "user": {...}}
+func (h *AuthController) Login(c *fiber.Ctx) error {
+	// 解析并校验请求体
+	var req model.LoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	token, user, err := h.auth.Login(c.UserContext(), req.Username, req.Password)
+	if err != nil {
+		return err
+	}
+	return c.JSON(model.LoginResponse{
+		Token:      token.Token,
+		ExpireTime: token.ExpireTime,
+		User:       model.NewUserResponse(user),
+	})
+}
+
+// GetMe 处理查询当前用户请求
+// - GET /me
+func (h *AuthController) GetMe(c *fiber.Ctx) error {
+	return c.JSON(model.NewUserResponse(currentUser(c)))
+}
+
+// GetUsage 处理查询当前用户资源占用请求
+// - GET /me/usage
+// - 返回：{"containers": {"used": 1, "limit": 2}, "workspaces": {...}, "disk_bytes": {...}}
+func (h *AuthController) GetUsage(c *fiber.Ctx) error {
+	usage, err := h.workspaces.GetUsage(c.UserContext(), currentUser(c))
+	if err != nil {
+		return err
+	}
+	return c.JSON(model.NewUsageResponse(usage, h.quota))
+}
+
+// CreateUser 处理创建用户请求（仅管理员）
+// - POST /admin/user
+// - Body：{"username": "alice", "password": "secret123", "role": "USER"}
+// - 返回：创建的用户
+func (h *AuthController) CreateUser(c *fiber.Ctx) error {
+	// 解析并校验请求体
+	var req model.CreateUserRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	user, err := h.auth.CreateUser(c.UserContext(), req.Username, req.Password, req.RoleEnt())
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusCreated).JSON(model.NewUserResponse(user))
+}
+
+// currentUser 返回 useAuth 中间件写入的当前用户
+func currentUser(c *fiber.Ctx) *ent.User {
+	user, _ := c.Locals("user").(*ent.User)
+	return user
+}
+
+// wsUser 返回 useAuth 中间件在握手阶段写入的当前用户
+func wsUser(c *websocket.Conn) *ent.User {
+	user, _ := c.Locals("user").(*ent.User)
+	return user
+}
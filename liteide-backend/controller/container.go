@@ -1 +1,244 @@
 package controller
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/gofiber/contrib/websocket" // 引入 Fiber WebSocket 库
+	"github.com/gofiber/fiber/v2"          // 引入 Fiber Web 框架
+	"github.com/gofiber/fiber/v2/log"      // 引入 Fiber 的日志库
+	"io"
+	"liteide-backend/controller/internal/model" // 引入请求/响应 DTO
+	"liteide-backend/repository/terminal"       // 引入终端 WebSocket 帧协议
+	"liteide-backend/repository/utils"          // 引入 WebSocket 数据转发工具
+	"liteide-backend/service"                   // 引入容器服务层
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ContainerController 容器、终端与程序运行相关的请求处理
+type ContainerController struct {
+	containers *service.ContainerService // 容器服务
+}
+
+// NewContainerController 创建容器控制器
+func NewContainerController(containers *service.ContainerService) *ContainerController {
+	return &ContainerController{containers: containers}
+}
+
+// CreateContainer 处理创建容器请求
+// - POST /container
+// - Body：{"workspace_id": 2}
+// - 返回：{"id": 123, "status": "created"}
+func (h *ContainerController) CreateContainer(c *fiber.Ctx) error {
+	// 解析请求体
+	var req model.CreateContainerRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	// 校验请求参数
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	// 调用服务层创建容器，错误交由 ErrorHandler 映射为 HTTP 状态码
+	id, err := h.containers.CreateContainer(c.UserContext(), currentUser(c), req.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.StatusResponse{
+		ID:     *id,
+		Status: "created",
+	})
+}
+
+// ListContainers 处理分页查询容器请求
+// - GET /container?page=1&size=10&status=UP&workspace_id=2&language=C
+// - 返回：{"total": 1, "items": [...]}
+func (h *ContainerController) ListContainers(c *fiber.Ctx) error {
+	// 解析并校验过滤参数
+	var query model.ListContainersQuery
+	if err := c.QueryParser(&query); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := query.Validate(); err != nil {
+		return err
+	}
+
+	// 分页参数由 usePagination 中间件解析
+	offset, _ := c.Locals("offset").(int)
+	limit, _ := c.Locals("limit").(int)
+
+	containers, total, err := h.containers.ListContainers(c.UserContext(), currentUser(c), query.Filter(), offset, limit)
+	if err != nil {
+		return err
+	}
+
+	items := make([]model.ContainerResponse, 0, len(containers))
+	for _, container := range containers {
+		items = append(items, model.NewContainerResponse(container))
+	}
+	return c.JSON(model.ListResponse[model.ContainerResponse]{Total: total, Items: items})
+}
+
+// GetContainer 处理查询容器详情请求
+// - GET /container/:id
+// - 返回：容器记录及 容器实例的实时状态
+func (h *ContainerController) GetContainer(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid container id")
+	}
+
+	detail, err := h.containers.GetContainer(c.UserContext(), currentUser(c), id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(model.NewContainerDetailResponse(detail))
+}
+
+// RemoveContainer 处理删除容器请求
+// - DELETE /container/:id
+// - 返回：{"id": 123, "status": "removed"}
+func (h *ContainerController) RemoveContainer(c *fiber.Ctx) error {
+	// 解析路径参数中的容器 ID
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid container id")
+	}
+
+	// 调用服务层删除容器
+	if err := h.containers.RemoveContainer(c.UserContext(), currentUser(c), id); err != nil {
+		return err
+	}
+
+	return c.JSON(model.StatusResponse{
+		ID:     id,
+		Status: "removed",
+	})
+}
+
+// AttachContainer 处理 WebSocket 终端连接
+// - GET /ws/container/:id
+// - 将 WebSocket 与容器内的 /bin/sh 进程双向连接，帧格式见 terminal 包文档
+// - 附加成功后发送 status 帧，空闲即将超时时发送 idle status 帧，Exec 进程退出后发送 exit 帧并正常关闭连接
+func (h *ContainerController) AttachContainer(c *websocket.Conn) {
+	ws := utils.NewWSConn(c)
+	// 确保函数退出时关闭 WebSocket 连接
+	defer func() { _ = ws.Close() }()
+
+	// 解析路径参数中的容器 ID（路由已限定为 int）
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		closeWSWithError(ws, websocket.CloseUnsupportedData, "invalid container id")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 附加到容器的 Exec 进程
+	session, err := h.containers.AttachContainer(ctx, wsUser(c), id)
+	if err != nil {
+		log.Errorf("failed to attach container %d: %v", id, err)
+		closeWSWithError(ws, websocket.CloseInternalServerErr, err.Error())
+		return
+	}
+	defer session.Close()
+
+	// 通知客户端已附加成功
+	_ = ws.WriteEvent(terminal.MessageStatus, terminal.Status{Status: terminal.StatusAttached})
+
+	// 转发空闲预警
+	warnings, unsubscribe := h.containers.SubscribeIdleWarning(id)
+	defer unsubscribe()
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case warning := <-warnings:
+				_ = ws.WriteEvent(terminal.MessageStatus, terminal.Status{
+					Status: terminal.StatusIdle,
+					Detail: fmt.Sprintf("container will be removed in %v unless there is activity", warning.Remaining.Round(time.Second)),
+				})
+			}
+		}
+	}()
+
+	// 终端输入与输出均视为容器活动
+	touch := func() { h.containers.TouchContainer(id) }
+	output := bufio.NewReader(activityReader{Reader: session.Reader, touch: touch})
+	input := activityWriter{Writer: session.Conn, touch: touch}
+
+	// 启动双向数据转发，任意一方结束都会触发 cancel
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go utils.WSWriterCopy(output, ws, &wg, cancel)
+	go utils.WSReaderCopy(ws, input, func(cols uint, rows uint) {
+		// 调整 Exec 的 PTY 尺寸，失败不影响终端使用
+		if err := session.Resize(ctx, cols, rows); err != nil {
+			log.Warnf("failed to resize exec of container %d: %v", id, err)
+		}
+	}, &wg, cancel)
+
+	// 任意一方结束后：关闭 Exec 连接以结束输出转发，
+	// 设置读超时以结束输入转发，但保留 WebSocket 用于发送退出信息
+	<-ctx.Done()
+	session.Close()
+	_ = ws.SetReadDeadline(time.Now())
+	wg.Wait()
+
+	// 查询 Exec 退出码（使用新的上下文，原上下文已取消）
+	inspectCtx, inspectCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer inspectCancel()
+	code, err := session.ExitCode(inspectCtx)
+	if err != nil {
+		// 客户端主动断开时进程可能仍在运行，此时无需上报退出码
+		log.Debugf("exec of container %d has no exit code: %v", id, err)
+		_ = ws.WriteClose(websocket.CloseNormalClosure, "")
+		return
+	}
+
+	// 发送最终的退出控制帧，然后正常关闭
+	_ = ws.WriteEvent(terminal.MessageExit, terminal.Exit{Code: code})
+	_ = ws.WriteClose(websocket.CloseNormalClosure, "")
+}
+
+// closeWSWithError 向客户端发送错误帧和关闭帧
+// - `code`：WebSocket 关闭码
+// - `message`：错误信息，错误帧携带完整信息，关闭帧中的原因可能被截断
+func closeWSWithError(ws *utils.WSConn, code int, message string) {
+	_ = ws.WriteEvent(terminal.MessageError, terminal.Error{Message: message})
+	_ = ws.WriteClose(code, message)
+}
+
+// activityReader 每次读到数据时记录一次活动
+type activityReader struct {
+	io.Reader
+	touch func()
+}
+
+// Read 读取数据并记录活动
+func (r activityReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.touch()
+	}
+	return n, err
+}
+
+// activityWriter 每次写入数据时记录一次活动
+type activityWriter struct {
+	io.Writer
+	touch func()
+}
+
+// Write 记录活动并写入数据
+func (w activityWriter) Write(p []byte) (int, error) {
+	w.touch()
+	return w.Writer.Write(p)
+}
@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"github.com/gofiber/contrib/websocket"      // 引入 Fiber WebSocket 库
+	"github.com/gofiber/fiber/v2"               // 引入 Fiber Web 框架
+	"github.com/gofiber/fiber/v2/log"           // 引入 Fiber 的日志库
+	"liteide-backend/controller/internal/model" // 引入请求/响应 DTO
+	"liteide-backend/repository/utils"          // 引入 WebSocket 关闭帧工具
+	"strconv"
+)
+
+// ListFiles 处理列出目录请求
+// - GET /workspace/:id/files?path=src
+// - 返回：[{"name": "main.c", "path": "src/main.c", "is_dir": false, ...}]
+func (h *WorkspaceController) ListFiles(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	files, err := h.workspaces.ListFiles(c.UserContext(), currentUser(c), id, c.Query("path"))
+	if err != nil {
+		return err
+	}
+
+	items := make([]model.FileResponse, 0, len(files))
+	for _, file := range files {
+		items = append(items, model.NewFileResponse(file))
+	}
+	return c.JSON(items)
+}
+
+// CreateFile 处理创建空文件或目录请求
+// - POST /workspace/:id/files
+// - Body：{"path": "src", "is_dir": true}
+func (h *WorkspaceController) CreateFile(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	// 解析并校验请求体
+	var req model.CreateFileRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.workspaces.CreateFile(c.UserContext(), currentUser(c), id, req.Path, req.IsDir); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusCreated)
+}
+
+// MoveFile 处理重命名或移动文件请求
+// - POST /workspace/:id/files/move
+// - Body：{"from": "main.c", "to": "src/main.c"}
+func (h *WorkspaceController) MoveFile(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	// 解析并校验请求体
+	var req model.MoveFileRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	if err := h.workspaces.MoveFile(c.UserContext(), currentUser(c), id, req.From, req.To); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// DeleteFile 处理删除文件或目录请求（目录递归删除）
+// - DELETE /workspace/:id/files?path=src/main.c
+func (h *WorkspaceController) DeleteFile(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	if err := h.workspaces.DeleteFile(c.UserContext(), currentUser(c), id, c.Query("path")); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ReadFile 处理读取文件内容请求
+// - GET /workspace/:id/files/content?path=src/main.c
+// - 返回：文件原始内容
+func (h *WorkspaceController) ReadFile(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	data, err := h.workspaces.ReadFile(c.UserContext(), currentUser(c), id, c.Query("path"))
+	if err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, fiber.MIMEOctetStream)
+	return c.Send(data)
+}
+
+// WriteFile 处理创建或覆盖文件请求
+// - PUT /workspace/:id/files/content?path=src/main.c
+// - Body：文件原始内容
+func (h *WorkspaceController) WriteFile(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	if err := h.workspaces.WriteFile(c.UserContext(), currentUser(c), id, c.Query("path"), c.Body()); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// WatchFiles 处理文件变更事件推送连接
+// - GET /ws/workspace/:id/events
+// - 该连接不使用终端的二进制协议帧，而是以 JSON 文本帧推送 {"events": [{"type": "modify", "path": "src/main.c"}]}
+// - 只有当前 goroutine 写入连接，无需 utils.WSConn 的写锁；错误只通过关闭帧的原因告知客户端
+// - 客户端发送的消息会被忽略，客户端断开后停止监听
+func (h *WorkspaceController) WatchFiles(c *websocket.Conn) {
+	// 确保函数退出时关闭 WebSocket 连接
+	defer func() { _ = c.Close() }()
+
+	// 解析路径参数中的工作区 ID（路由已限定为 int）
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		closeWS(c, websocket.CloseUnsupportedData, "invalid workspace id")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 开始监听工作区目录
+	events, err := h.workspaces.WatchWorkspace(ctx, wsUser(c), id)
+	if err != nil {
+		log.Errorf("failed to watch workspace %d: %v", id, err)
+		closeWS(c, websocket.CloseInternalServerErr, err.Error())
+		return
+	}
+
+	// 读取客户端消息以感知断开
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	// 推送事件批次，直到客户端断开或监听结束
+	for batch := range events {
+		if err := c.WriteJSON(model.NewFileEventsMessage(batch)); err != nil {
+			return
+		}
+	}
+	closeWS(c, websocket.CloseNormalClosure, "")
+}
+
+// closeWS 向客户端发送关闭帧
+// - `code`：WebSocket 关闭码
+// - `reason`：关闭原因，过长时截断
+func closeWS(c *websocket.Conn, code int, reason string) {
+	_ = c.WriteMessage(websocket.CloseMessage, utils.CloseMessage(code, reason))
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"               // 引入 Fiber Web 框架
+	"liteide-backend/controller/internal/model" // 引入请求/响应 DTO
+	"liteide-backend/service"                   // 引入镜像服务层
+)
+
+// ImageController 镜像目录与语言工具链相关的请求处理
+type ImageController struct {
+	images *service.ImageService // 镜像服务
+}
+
+// NewImageController 创建镜像控制器
+func NewImageController(images *service.ImageService) *ImageController {
+	return &ImageController{images: images}
+}
+
+// ListImages 处理分页查询镜像目录请求
+// - GET /admin/image?page=1&size=10
+// - 返回：{"total": 1, "items": [...]}
+func (h *ImageController) ListImages(c *fiber.Ctx) error {
+	// 分页参数由 usePagination 中间件解析
+	offset, _ := c.Locals("offset").(int)
+	limit, _ := c.Locals("limit").(int)
+
+	images, total, err := h.images.ListImages(c.UserContext(), offset, limit)
+	if err != nil {
+		return err
+	}
+
+	items := make([]model.ImageResponse, 0, len(images))
+	for _, image := range images {
+		items = append(items, model.NewImageResponse(image))
+	}
+	return c.JSON(model.ListResponse[model.ImageResponse]{Total: total, Items: items})
+}
+
+// CreateImage 处理创建镜像请求
+// - POST /admin/image
+// - Body：{"language": "C", "image_name": "gcc:13", "digest": "sha256:…"}
+// - 返回：创建的镜像，镜像随后在后台预拉取
+func (h *ImageController) CreateImage(c *fiber.Ctx) error {
+	// 解析并校验请求体
+	var req model.ImageRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := req.ValidateCreate(); err != nil {
+		return err
+	}
+
+	image, err := h.images.CreateImage(c.UserContext(), req.Input())
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusCreated).JSON(model.NewImageResponse(image))
+}
+
+// UpdateImage 处理更新镜像请求
+// - PATCH /admin/image/:id
+// - Body：{"image_name": "gcc:14", "digest": ""}
+// - 返回：更新后的镜像
+func (h *ImageController) UpdateImage(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid image id")
+	}
+
+	// 解析并校验请求体
+	var req model.ImageRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	image, err := h.images.UpdateImage(c.UserContext(), id, req.Input())
+	if err != nil {
+		return err
+	}
+	return c.JSON(model.NewImageResponse(image))
+}
+
+// DeleteImage 处理删除镜像请求
+// - DELETE /admin/image/:id
+// - 返回：{"id": 1, "status": "removed"}
+func (h *ImageController) DeleteImage(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid image id")
+	}
+
+	if err := h.images.DeleteImage(c.UserContext(), id); err != nil {
+		return err
+	}
+	return c.JSON(model.StatusResponse{ID: id, Status: "removed"})
+}
+
+// PullImage 处理重新预拉取镜像请求
+// - POST /admin/image/:id/pull
+// - 返回：{"id": 1, "status": "queued"}，拉取结果通过 pull_status 查询
+func (h *ImageController) PullImage(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid image id")
+	}
+
+	if err := h.images.PullImage(c.UserContext(), id); err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusAccepted).JSON(model.StatusResponse{ID: id, Status: "queued"})
+}
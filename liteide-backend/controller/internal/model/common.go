@@ -0,0 +1,14 @@
+package model
+
+// StatusResponse 资源操作的响应体
+// 例如：{"id": 123, "status": "created"}
+type StatusResponse struct {
+	ID     int    `json:"id"`     // 资源 ID
+	Status string `json:"status"` // 操作结果状态
+}
+
+// ListResponse 分页列表的响应体
+type ListResponse[T any] struct {
+	Total int `json:"total"` // 总数
+	Items []T `json:"items"` // 当前页数据
+}
@@ -1 +1,157 @@
 package model
+
+import (
+	"github.com/gofiber/fiber/v2"                // 引入 Fiber Web 框架，用于构造校验错误
+	"liteide-backend/ent"                        // 引入 ent ORM 生成的实体
+	"liteide-backend/ent/property"               // 引入 ent ORM 生成的 property 模型
+	repoModel "liteide-backend/repository/model" // 引入语言类型转换
+	"liteide-backend/service"                    // 引入容器服务层的查询类型
+	"slices"
+	"time"
+)
+
+// CreateContainerRequest 创建容器的请求体
+// 例如：{"workspace_id": 2}，容器属于当前登录用户
+type CreateContainerRequest struct {
+	WorkspaceID int `json:"workspace_id"` // 关联的工作区 ID
+}
+
+// Validate 校验创建容器的请求参数
+func (r *CreateContainerRequest) Validate() error {
+	if r.WorkspaceID <= 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "workspace_id must be a positive integer")
+	}
+	return nil
+}
+
+// RunContainerRequest 运行程序的请求体
+// 例如：{"file": "main.c", "timeout": 10}
+type RunContainerRequest struct {
+	File    string `json:"file"`    // 入口文件，为空时使用语言的默认入口文件
+	Timeout int    `json:"timeout"` // 超时时间（秒），为 0 时使用默认值
+}
+
+// Validate 校验运行程序的请求参数（超时上限由服务层校验）
+func (r *RunContainerRequest) Validate() error {
+	if r.Timeout < 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "timeout must not be negative")
+	}
+	return nil
+}
+
+// RunContainerResponse 运行程序的响应体
+type RunContainerResponse struct {
+	Stdout     string `json:"stdout"`      // 标准输出
+	Stderr     string `json:"stderr"`      // 标准错误
+	Truncated  bool   `json:"truncated"`   // 输出是否因超过上限被截断
+	ExitCode   int    `json:"exit_code"`   // 进程退出码
+	DurationMs int64  `json:"duration_ms"` // 运行耗时（毫秒）
+	TimedOut   bool   `json:"timed_out"`   // 是否因超时被终止
+}
+
+// ListContainersQuery 查询容器列表的过滤参数
+// 例如：GET /container?status=UP&workspace_id=2&language=C
+type ListContainersQuery struct {
+	Status      string `query:"status"`       // 容器状态，可选
+	WorkspaceID int    `query:"workspace_id"` // 工作区 ID，可选
+	Language    string `query:"language"`     // 镜像语言，可选
+}
+
+// Validate 校验容器列表的过滤参数
+func (q *ListContainersQuery) Validate() error {
+	if q.Status != "" && !slices.Contains(property.ContainerStatus("").Values(), q.Status) {
+		return fiber.NewError(fiber.StatusBadRequest, "unsupported status")
+	}
+	if q.WorkspaceID < 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "workspace_id must be a positive integer")
+	}
+	return nil
+}
+
+// Filter 转换为服务层使用的过滤条件
+func (q *ListContainersQuery) Filter() service.ContainerFilter {
+	var filter service.ContainerFilter
+	if q.Status != "" {
+		status := property.ContainerStatus(q.Status)
+		filter.Status = &status
+	}
+	if q.WorkspaceID > 0 {
+		filter.WorkspaceID = &q.WorkspaceID
+	}
+	if language := repoModel.Language(q.Language).ToEnt(); language != "" {
+		filter.Language = &language
+	}
+	return filter
+}
+
+// ContainerImageResponse 容器使用的镜像
+type ContainerImageResponse struct {
+	ID        int               `json:"id"`         // 镜像 ID
+	ImageName string            `json:"image_name"` // Docker 镜像名称
+	Language  property.Language `json:"language"`   // 编程语言
+}
+
+// ContainerWorkspaceResponse 容器挂载的工作区
+type ContainerWorkspaceResponse struct {
+	ID   int    `json:"id"`   // 工作区 ID
+	Name string `json:"name"` // 工作区名称
+}
+
+// ContainerResponse 容器的响应体
+type ContainerResponse struct {
+	ID            int                         `json:"id"`             // 容器记录 ID
+	UserID        int                         `json:"user_id"`        // 创建容器的用户 ID
+	Status        property.ContainerStatus    `json:"status"`         // 容器状态
+	StatusMessage *string                     `json:"status_message"` // 状态说明，例如启动失败的原因
+	Image         *ContainerImageResponse     `json:"image"`          // 使用的镜像
+	Workspace     *ContainerWorkspaceResponse `json:"workspace"`      // 挂载的工作区
+	CreateTime    time.Time                   `json:"create_time"`    // 创建时间
+	ExitTime      *time.Time                  `json:"exit_time"`      // 删除时间
+}
+
+// NewContainerResponse 将容器实体（需已加载镜像与工作区）转换为响应体
+func NewContainerResponse(c *ent.Container) ContainerResponse {
+	response := ContainerResponse{
+		ID:            c.ID,
+		UserID:        c.UserID,
+		Status:        c.ContainerStatus,
+		StatusMessage: c.StatusMessage,
+		CreateTime:    c.CreateTime,
+		ExitTime:      c.ExitTime,
+	}
+	if i := c.Edges.Image; i != nil {
+		response.Image = &ContainerImageResponse{ID: i.ID, ImageName: i.ImageName, Language: i.Language}
+	}
+	if w := c.Edges.Workspace; w != nil {
+		response.Workspace = &ContainerWorkspaceResponse{ID: w.ID, Name: w.Name}
+	}
+	return response
+}
+
+// TaskResponse 容器实例的实时状态
+type TaskResponse struct {
+	State      string    `json:"state"`       // 实例状态，例如 Swarm 任务的 running、failed 或 Docker 容器的 running、exited
+	Message    string    `json:"message"`     // 状态说明或错误信息
+	NodeID     string    `json:"node_id"`     // 实例所在的节点 ID，单机 Docker 为空
+	UpdateTime time.Time `json:"update_time"` // 状态更新时间
+}
+
+// ContainerDetailResponse 容器详情的响应体
+type ContainerDetailResponse struct {
+	ContainerResponse
+	Task *TaskResponse `json:"task"` // 容器实例的实时状态，不可用时为 null
+}
+
+// NewContainerDetailResponse 将容器详情转换为响应体
+func NewContainerDetailResponse(d *service.ContainerDetail) ContainerDetailResponse {
+	response := ContainerDetailResponse{ContainerResponse: NewContainerResponse(d.Container)}
+	if d.Task != nil {
+		response.Task = &TaskResponse{
+			State:      d.Task.State,
+			Message:    d.Task.Message,
+			NodeID:     d.Task.NodeID,
+			UpdateTime: d.Task.UpdateTime,
+		}
+	}
+	return response
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"github.com/gofiber/fiber/v2" // 引入 Fiber Web 框架，用于构造校验错误
+	"liteide-backend/service"     // 引入服务层的文件信息
+	"time"
+)
+
+// CreateFileRequest 创建文件或目录的请求体
+// 例如：{"path": "src/main.c", "is_dir": false}
+type CreateFileRequest struct {
+	Path  string `json:"path"`   // 相对工作区根目录的路径
+	IsDir bool   `json:"is_dir"` // 是否创建目录
+}
+
+// Validate 校验创建文件的请求参数
+func (r *CreateFileRequest) Validate() error {
+	if r.Path == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "path is required")
+	}
+	return nil
+}
+
+// MoveFileRequest 重命名或移动文件的请求体
+// 例如：{"from": "main.c", "to": "src/main.c"}
+type MoveFileRequest struct {
+	From string `json:"from"` // 源路径
+	To   string `json:"to"`   // 目标路径
+}
+
+// Validate 校验移动文件的请求参数
+func (r *MoveFileRequest) Validate() error {
+	if r.From == "" || r.To == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "from and to are required")
+	}
+	return nil
+}
+
+// FileResponse 文件或目录信息的响应体
+type FileResponse struct {
+	Name    string    `json:"name"`     // 文件名
+	Path    string    `json:"path"`     // 相对工作区根目录的路径
+	IsDir   bool      `json:"is_dir"`   // 是否为目录
+	Size    int64     `json:"size"`     // 文件大小（字节）
+	ModTime time.Time `json:"mod_time"` // 最后修改时间
+}
+
+// NewFileResponse 将服务层的文件信息转换为响应体
+func NewFileResponse(f service.FileInfo) FileResponse {
+	return FileResponse{
+		Name:    f.Name,
+		Path:    f.Path,
+		IsDir:   f.IsDir,
+		Size:    f.Size,
+		ModTime: f.ModTime,
+	}
+}
+
+// FileEventResponse 文件变更事件
+type FileEventResponse struct {
+	Type string `json:"type"` // 事件类型：create、modify、delete、rename
+	Path string `json:"path"` // 相对工作区根目录的路径
+}
+
+// FileEventsMessage 文件变更事件推送消息（一次推送一个合并后的批次）
+// 例如：{"events": [{"type": "modify", "path": "src/main.c"}]}
+type FileEventsMessage struct {
+	Events []FileEventResponse `json:"events"` // 事件列表
+}
+
+// NewFileEventsMessage 将服务层的事件批次转换为推送消息
+func NewFileEventsMessage(batch []service.FileEvent) FileEventsMessage {
+	events := make([]FileEventResponse, 0, len(batch))
+	for _, event := range batch {
+		events = append(events, FileEventResponse{Type: event.Type, Path: event.Path})
+	}
+	return FileEventsMessage{Events: events}
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"github.com/gofiber/fiber/v2"                // 引入 Fiber Web 框架，用于构造校验错误
+	"liteide-backend/ent"                        // 引入 ent ORM 生成的实体
+	"liteide-backend/ent/property"               // 引入 ent ORM 生成的 property 模型
+	repoModel "liteide-backend/repository/model" // 引入语言类型转换
+	"liteide-backend/service"                    // 引入镜像服务层的输入类型
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// digestPattern 镜像摘要格式
+var digestPattern = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// ImageRequest 创建或更新镜像的请求体
+// 例如：{"language": "C", "image_name": "gcc:13", "digest": "sha256:…", "memory_bytes": 268435456}
+// - 更新时省略的字段保持不变；digest、network_mode 为空字符串或资源字段为 0 表示恢复默认值
+type ImageRequest struct {
+	Language    *string `json:"language"`     // 编程语言
+	ImageName   *string `json:"image_name"`   // Docker 镜像名称，可带标签，例如 gcc:13
+	Digest      *string `json:"digest"`       // 镜像摘要，用于固定镜像版本
+	NanoCPUs    *int64  `json:"nano_cpus"`    // CPU 上限（单位：1e-9 核）
+	MemoryBytes *int64  `json:"memory_bytes"` // 内存上限（字节）
+	PidsLimit   *int64  `json:"pids_limit"`   // 进程数上限
+	NetworkMode *string `json:"network_mode"` // 网络隔离策略
+}
+
+// ValidateCreate 校验创建镜像的请求参数
+func (r *ImageRequest) ValidateCreate() error {
+	if r.Language == nil || r.ImageName == nil {
+		return fiber.NewError(fiber.StatusBadRequest, "language and image_name are required")
+	}
+	return r.Validate()
+}
+
+// Validate 校验镜像请求中已提供的字段
+func (r *ImageRequest) Validate() error {
+	if r.Language != nil && repoModel.Language(*r.Language).ToEnt() == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "language must not be empty")
+	}
+	if r.ImageName != nil {
+		// 摘要通过 digest 字段单独指定
+		if *r.ImageName == "" || strings.ContainsAny(*r.ImageName, "@ \t\n") {
+			return fiber.NewError(fiber.StatusBadRequest, "image_name must be a non-empty image name without digest")
+		}
+	}
+	if r.Digest != nil && *r.Digest != "" && !digestPattern.MatchString(*r.Digest) {
+		return fiber.NewError(fiber.StatusBadRequest, "digest must look like sha256:<64 hex characters>")
+	}
+	for _, value := range []*int64{r.NanoCPUs, r.MemoryBytes, r.PidsLimit} {
+		if value != nil && *value < 0 {
+			return fiber.NewError(fiber.StatusBadRequest, "resource limits must not be negative")
+		}
+	}
+	if r.NetworkMode != nil && *r.NetworkMode != "" && !slices.Contains(property.NetworkMode("").Values(), *r.NetworkMode) {
+		return fiber.NewError(fiber.StatusBadRequest, "unsupported network_mode")
+	}
+	return nil
+}
+
+// Input 转换为服务层使用的镜像字段
+func (r *ImageRequest) Input() service.ImageInput {
+	input := service.ImageInput{
+		ImageName:   r.ImageName,
+		Digest:      r.Digest,
+		NanoCPUs:    r.NanoCPUs,
+		MemoryBytes: r.MemoryBytes,
+		PidsLimit:   r.PidsLimit,
+	}
+	if r.Language != nil {
+		language := repoModel.Language(*r.Language).ToEnt()
+		input.Language = &language
+	}
+	if r.NetworkMode != nil {
+		mode := property.NetworkMode(*r.NetworkMode)
+		input.NetworkMode = &mode
+	}
+	return input
+}
+
+// ImageResponse 镜像的响应体
+type ImageResponse struct {
+	ID          int                      `json:"id"`           // 镜像 ID
+	Language    property.Language        `json:"language"`     // 编程语言
+	ImageName   string                   `json:"image_name"`   // Docker 镜像名称
+	Digest      *string                  `json:"digest"`       // 镜像摘要
+	NanoCPUs    *int64                   `json:"nano_cpus"`    // CPU 上限
+	MemoryBytes *int64                   `json:"memory_bytes"` // 内存上限
+	PidsLimit   *int64                   `json:"pids_limit"`   // 进程数上限
+	NetworkMode *property.NetworkMode    `json:"network_mode"` // 网络隔离策略
+	PullStatus  property.ImagePullStatus `json:"pull_status"`  // 预拉取状态
+	PullMessage *string                  `json:"pull_message"` // 预拉取失败原因
+	PullTime    *time.Time               `json:"pull_time"`    // 最近一次预拉取完成的时间
+}
+
+// NewImageResponse 将镜像实体转换为响应体
+func NewImageResponse(i *ent.Image) ImageResponse {
+	return ImageResponse{
+		ID:          i.ID,
+		Language:    i.Language,
+		ImageName:   i.ImageName,
+		Digest:      i.Digest,
+		NanoCPUs:    i.NanoCpus,
+		MemoryBytes: i.MemoryBytes,
+		PidsLimit:   i.PidsLimit,
+		NetworkMode: i.NetworkMode,
+		PullStatus:  i.PullStatus,
+		PullMessage: i.PullMessage,
+		PullTime:    i.PullTime,
+	}
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"liteide-backend/ent/property"               // 引入 ent ORM 生成的 property 模型
+	repoModel "liteide-backend/repository/model" // 引入语言工具链定义
+)
+
+// LanguageResponse 语言工具链的响应体
+type LanguageResponse struct {
+	Name         property.Language `json:"name"`          // 语言名称
+	DisplayName  string            `json:"display_name"`  // 显示名称
+	Extensions   []string          `json:"extensions"`    // 源文件扩展名
+	DefaultEntry string            `json:"default_entry"` // 默认入口文件
+	Compiled     bool              `json:"compiled"`      // 是否需要编译
+}
+
+// NewLanguageResponse 将语言工具链转换为响应体
+func NewLanguageResponse(t repoModel.Toolchain) LanguageResponse {
+	return LanguageResponse{
+		Name:         t.Name,
+		DisplayName:  t.DisplayName,
+		Extensions:   t.Extensions,
+		DefaultEntry: t.DefaultEntry,
+		Compiled:     t.CompileCommand != "",
+	}
+}
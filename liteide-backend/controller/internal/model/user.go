@@ -0,0 +1,101 @@
+package model
+
+import (
+	"github.com/gofiber/fiber/v2"  // 引入 Fiber Web 框架，用于构造校验错误
+	"liteide-backend/config"       // 引入配额配置
+	"liteide-backend/ent"          // 引入 ent ORM 生成的实体
+	"liteide-backend/ent/property" // 引入 ent ORM 生成的 property 模型
+	"liteide-backend/service"      // 引入资源占用统计
+	"slices"
+	"time"
+)
+
+// LoginRequest 登录的请求体
+// 例如：{"username": "alice", "password": "secret123"}
+type LoginRequest struct {
+	Username string `json:"username"` // 用户名
+	Password string `json:"password"` // 明文密码
+}
+
+// Validate 校验登录的请求参数
+func (r *LoginRequest) Validate() error {
+	if r.Username == "" || r.Password == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "username and password are required")
+	}
+	return nil
+}
+
+// LoginResponse 登录的响应体
+type LoginResponse struct {
+	Token      string       `json:"token"`       // 访问令牌
+	ExpireTime time.Time    `json:"expire_time"` // 令牌过期时间
+	User       UserResponse `json:"user"`        // 当前用户
+}
+
+// CreateUserRequest 创建用户的请求体
+// 例如：{"username": "alice", "password": "secret123", "role": "USER"}
+type CreateUserRequest struct {
+	Username string `json:"username"` // 用户名
+	Password string `json:"password"` // 明文密码（长度由服务层校验）
+	Role     string `json:"role"`     // 用户角色，为空时为 USER
+}
+
+// Validate 校验创建用户的请求参数（用户名格式由 ent 校验）
+func (r *CreateUserRequest) Validate() error {
+	if r.Username == "" || r.Password == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "username and password are required")
+	}
+	if r.Role != "" && !slices.Contains(property.UserRole("").Values(), r.Role) {
+		return fiber.NewError(fiber.StatusBadRequest, "unsupported role")
+	}
+	return nil
+}
+
+// RoleEnt 返回 ent 使用的用户角色，未指定时为 USER
+func (r *CreateUserRequest) RoleEnt() property.UserRole {
+	if r.Role == "" {
+		return property.UserRoleUser
+	}
+	return property.UserRole(r.Role)
+}
+
+// UserResponse 用户的响应体（不包含密码哈希）
+type UserResponse struct {
+	ID         int               `json:"id"`          // 用户 ID
+	Username   string            `json:"username"`    // 用户名
+	Role       property.UserRole `json:"role"`        // 用户角色
+	CreateTime time.Time         `json:"create_time"` // 创建时间
+}
+
+// NewUserResponse 将用户实体转换为响应体
+func NewUserResponse(u *ent.User) UserResponse {
+	return UserResponse{
+		ID:         u.ID,
+		Username:   u.Username,
+		Role:       u.Role,
+		CreateTime: u.CreateTime,
+	}
+}
+
+// QuotaUsage 单项资源的占用与上限
+type QuotaUsage[T int | int64] struct {
+	Used  T `json:"used"`  // 当前占用
+	Limit T `json:"limit"` // 上限，0 表示不限制
+}
+
+// UsageResponse 当前用户资源占用的响应体
+// 例如：{"containers": {"used": 1, "limit": 2}, "workspaces": {...}, "disk_bytes": {...}}
+type UsageResponse struct {
+	Containers QuotaUsage[int]   `json:"containers"` // 运行中的容器数
+	Workspaces QuotaUsage[int]   `json:"workspaces"` // 工作区数
+	DiskBytes  QuotaUsage[int64] `json:"disk_bytes"` // 工作区磁盘占用（字节）
+}
+
+// NewUsageResponse 将资源占用与配额配置转换为响应体
+func NewUsageResponse(usage *service.Usage, quota config.QuotaConfig) UsageResponse {
+	return UsageResponse{
+		Containers: QuotaUsage[int]{Used: usage.Containers, Limit: quota.MaxContainers},
+		Workspaces: QuotaUsage[int]{Used: usage.Workspaces, Limit: quota.MaxWorkspaces},
+		DiskBytes:  QuotaUsage[int64]{Used: usage.DiskBytes, Limit: quota.MaxDiskBytes},
+	}
+}
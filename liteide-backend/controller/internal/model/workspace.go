@@ -0,0 +1,91 @@
+package model
+
+import (
+	"github.com/gofiber/fiber/v2"                // 引入 Fiber Web 框架，用于构造校验错误
+	"liteide-backend/ent"                        // 引入 ent ORM 生成的实体
+	"liteide-backend/ent/property"               // 引入 ent ORM 生成的 property 模型
+	repoModel "liteide-backend/repository/model" // 引入语言类型转换
+	"slices"
+	"time"
+	"unicode/utf8"
+)
+
+// maxWorkspaceNameLength 工作区名称的最大长度（字符数）
+const maxWorkspaceNameLength = 64
+
+// CreateWorkspaceRequest 创建工作区的请求体
+// 例如：{"name": "hello", "language": "C", "network_mode": "NONE"}
+type CreateWorkspaceRequest struct {
+	Name        string  `json:"name"`         // 工作区名称
+	Language    string  `json:"language"`     // 编程语言
+	NetworkMode *string `json:"network_mode"` // 网络隔离策略，可选
+}
+
+// Validate 校验创建工作区的请求参数
+func (r *CreateWorkspaceRequest) Validate() error {
+	if err := validateWorkspaceName(r.Name); err != nil {
+		return err
+	}
+	if r.LanguageEnt() == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "language is required")
+	}
+	if r.NetworkMode != nil && !slices.Contains(property.NetworkMode("").Values(), *r.NetworkMode) {
+		return fiber.NewError(fiber.StatusBadRequest, "unsupported network_mode")
+	}
+	return nil
+}
+
+// LanguageEnt 返回 ent 使用的语言类型（是否受支持由服务层校验）
+func (r *CreateWorkspaceRequest) LanguageEnt() property.Language {
+	return repoModel.Language(r.Language).ToEnt()
+}
+
+// NetworkModeEnt 返回 ent 使用的网络隔离策略，未指定时返回 nil
+func (r *CreateWorkspaceRequest) NetworkModeEnt() *property.NetworkMode {
+	if r.NetworkMode == nil {
+		return nil
+	}
+	mode := property.NetworkMode(*r.NetworkMode)
+	return &mode
+}
+
+// RenameWorkspaceRequest 重命名工作区的请求体
+// 例如：{"name": "new-name"}
+type RenameWorkspaceRequest struct {
+	Name string `json:"name"` // 新的工作区名称
+}
+
+// Validate 校验重命名工作区的请求参数
+func (r *RenameWorkspaceRequest) Validate() error {
+	return validateWorkspaceName(r.Name)
+}
+
+// validateWorkspaceName 校验工作区名称：非空且不超过最大长度
+func validateWorkspaceName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > maxWorkspaceNameLength {
+		return fiber.NewError(fiber.StatusBadRequest, "name must be 1-64 characters")
+	}
+	return nil
+}
+
+// WorkspaceResponse 工作区的响应体
+type WorkspaceResponse struct {
+	ID          int                   `json:"id"`           // 工作区 ID
+	UUID        string                `json:"uuid"`         // 工作区目录名
+	Name        string                `json:"name"`         // 工作区名称
+	Language    property.Language     `json:"language"`     // 编程语言
+	NetworkMode *property.NetworkMode `json:"network_mode"` // 网络隔离策略
+	CreateTime  time.Time             `json:"create_time"`  // 创建时间
+}
+
+// NewWorkspaceResponse 将工作区实体转换为响应体
+func NewWorkspaceResponse(w *ent.Workspace) WorkspaceResponse {
+	return WorkspaceResponse{
+		ID:          w.ID,
+		UUID:        w.UUID.String(),
+		Name:        w.Name,
+		Language:    w.Language,
+		NetworkMode: w.NetworkMode,
+		CreateTime:  w.CreateTime,
+	}
+}
@@ -0,0 +1,18 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"               // 引入 Fiber Web 框架
+	"liteide-backend/controller/internal/model" // 引入请求/响应 DTO
+)
+
+// ListLanguages 处理查询可用语言请求
+// - GET /language
+// - 返回：[{"name": "C", "display_name": "C", "extensions": [".c", ".h"], ...}]
+func (h *ImageController) ListLanguages(c *fiber.Ctx) error {
+	toolchains := h.images.ListLanguages()
+	items := make([]model.LanguageResponse, 0, len(toolchains))
+	for _, toolchain := range toolchains {
+		items = append(items, model.NewLanguageResponse(toolchain))
+	}
+	return c.JSON(items)
+}
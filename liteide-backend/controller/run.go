@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"github.com/gofiber/contrib/websocket"      // 引入 Fiber WebSocket 库
+	"github.com/gofiber/fiber/v2"               // 引入 Fiber Web 框架
+	"github.com/gofiber/fiber/v2/log"           // 引入 Fiber 的日志库
+	"liteide-backend/controller/internal/model" // 引入请求/响应 DTO
+	"liteide-backend/repository/terminal"       // 引入终端 WebSocket 帧协议
+	"liteide-backend/repository/utils"          // 引入 WebSocket 工具
+	"strconv"
+	"time"
+)
+
+// maxRunOutput 一次性返回的运行输出上限（标准输出与标准错误分别计算）
+const maxRunOutput = 1 << 20
+
+// RunContainer 处理非交互运行程序请求
+// - POST /container/:id/run
+// - Body：{"file": "main.c", "timeout": 10}
+// - 返回：{"stdout": "...", "stderr": "...", "exit_code": 0, "duration_ms": 120, "timed_out": false}
+func (h *ContainerController) RunContainer(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid container id")
+	}
+
+	// 解析并校验请求体（请求体可为空）
+	var req model.RunContainerRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	stdout := &limitedBuffer{limit: maxRunOutput}
+	stderr := &limitedBuffer{limit: maxRunOutput}
+	result, err := h.containers.RunContainer(c.UserContext(), currentUser(c), id, req.File, time.Duration(req.Timeout)*time.Second, stdout, stderr)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(model.RunContainerResponse{
+		Stdout:     stdout.String(),
+		Stderr:     stderr.String(),
+		Truncated:  stdout.truncated || stderr.truncated,
+		ExitCode:   result.ExitCode,
+		DurationMs: result.Duration.Milliseconds(),
+		TimedOut:   result.TimedOut,
+	})
+}
+
+// RunContainerWS 处理流式运行程序连接
+// - GET /ws/container/:id/run?file=main.c&timeout=10
+// - 以 terminal 协议推送输出：'0' 为标准输出，'9' 为标准错误，结束时发送 exit 帧并正常关闭
+func (h *ContainerController) RunContainerWS(c *websocket.Conn) {
+	ws := utils.NewWSConn(c)
+	// 确保函数退出时关闭 WebSocket 连接
+	defer func() { _ = ws.Close() }()
+
+	// 解析路径参数与查询参数
+	id, err := strconv.Atoi(c.Params("id"))
+	if err != nil {
+		closeWSWithError(ws, websocket.CloseUnsupportedData, "invalid container id")
+		return
+	}
+	timeout, err := strconv.Atoi(c.Query("timeout", "0"))
+	if err != nil || timeout < 0 {
+		closeWSWithError(ws, websocket.CloseUnsupportedData, "invalid timeout")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 读取客户端消息以感知断开，断开后终止运行
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	stdout := frameWriter{ws: ws, t: terminal.MessageData}
+	stderr := frameWriter{ws: ws, t: terminal.MessageStderr}
+	result, err := h.containers.RunContainer(ctx, wsUser(c), id, c.Query("file"), time.Duration(timeout)*time.Second, stdout, stderr)
+	if err != nil {
+		log.Errorf("failed to run container %d: %v", id, err)
+		closeWSWithError(ws, websocket.CloseInternalServerErr, err.Error())
+		return
+	}
+
+	// 发送最终的退出控制帧，然后正常关闭
+	_ = ws.WriteEvent(terminal.MessageExit, terminal.Exit{
+		Code:       result.ExitCode,
+		DurationMs: result.Duration.Milliseconds(),
+		TimedOut:   result.TimedOut,
+	})
+	_ = ws.WriteClose(websocket.CloseNormalClosure, "")
+}
+
+// frameWriter 将写入的数据以指定类型的协议帧发送
+type frameWriter struct {
+	ws *utils.WSConn
+	t  terminal.MessageType
+}
+
+// Write 发送一个协议帧
+func (w frameWriter) Write(p []byte) (int, error) {
+	if err := w.ws.WriteFrame(w.t, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// limitedBuffer 超过上限后丢弃多余数据的缓冲区
+type limitedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+// Write 写入数据，超过上限的部分被丢弃但仍视为写入成功，避免阻塞程序输出
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.Len(); len(p) > remaining {
+		b.truncated = true
+		b.Buffer.Write(p[:max(remaining, 0)])
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"github.com/gofiber/fiber/v2"               // 引入 Fiber Web 框架
+	"liteide-backend/controller/internal/model" // 引入请求/响应 DTO
+	"liteide-backend/service"                   // 引入工作区服务层
+)
+
+// WorkspaceController 工作区与工作区文件相关的请求处理
+type WorkspaceController struct {
+	workspaces *service.WorkspaceService // 工作区服务
+}
+
+// NewWorkspaceController 创建工作区控制器
+func NewWorkspaceController(workspaces *service.WorkspaceService) *WorkspaceController {
+	return &WorkspaceController{workspaces: workspaces}
+}
+
+// CreateWorkspace 处理创建工作区请求
+// - POST /workspace
+// - Body：{"name": "hello", "language": "C", "network_mode": "NONE"}
+// - 返回：创建的工作区
+func (h *WorkspaceController) CreateWorkspace(c *fiber.Ctx) error {
+	// 解析并校验请求体
+	var req model.CreateWorkspaceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	// 创建工作区记录及目录
+	workspace, err := h.workspaces.CreateWorkspace(c.UserContext(), currentUser(c), req.Name, req.LanguageEnt(), req.NetworkModeEnt())
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.NewWorkspaceResponse(workspace))
+}
+
+// ListWorkspaces 处理分页查询工作区请求
+// - GET /workspace?page=1&size=10
+// - 返回：{"total": 1, "items": [...]}
+func (h *WorkspaceController) ListWorkspaces(c *fiber.Ctx) error {
+	// 分页参数由 usePagination 中间件解析
+	offset, _ := c.Locals("offset").(int)
+	limit, _ := c.Locals("limit").(int)
+
+	workspaces, total, err := h.workspaces.ListWorkspaces(c.UserContext(), currentUser(c), offset, limit)
+	if err != nil {
+		return err
+	}
+
+	items := make([]model.WorkspaceResponse, 0, len(workspaces))
+	for _, workspace := range workspaces {
+		items = append(items, model.NewWorkspaceResponse(workspace))
+	}
+	return c.JSON(model.ListResponse[model.WorkspaceResponse]{Total: total, Items: items})
+}
+
+// GetWorkspace 处理查询单个工作区请求
+// - GET /workspace/:id
+func (h *WorkspaceController) GetWorkspace(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	workspace, err := h.workspaces.GetWorkspace(c.UserContext(), currentUser(c), id)
+	if err != nil {
+		return err
+	}
+	return c.JSON(model.NewWorkspaceResponse(workspace))
+}
+
+// RenameWorkspace 处理重命名工作区请求
+// - PATCH /workspace/:id
+// - Body：{"name": "new-name"}
+func (h *WorkspaceController) RenameWorkspace(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	// 解析并校验请求体
+	var req model.RenameWorkspaceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	workspace, err := h.workspaces.RenameWorkspace(c.UserContext(), currentUser(c), id, req.Name)
+	if err != nil {
+		return err
+	}
+	return c.JSON(model.NewWorkspaceResponse(workspace))
+}
+
+// DeleteWorkspace 处理删除工作区请求
+// - DELETE /workspace/:id
+// - 返回：{"id": 123, "status": "removed"}
+func (h *WorkspaceController) DeleteWorkspace(c *fiber.Ctx) error {
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid workspace id")
+	}
+
+	if err := h.workspaces.DeleteWorkspace(c.UserContext(), currentUser(c), id); err != nil {
+		return err
+	}
+	return c.JSON(model.StatusResponse{ID: id, Status: "removed"})
+}
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/user"
+	"liteide-backend/ent/workspace"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Container is the model entity for the Container schema.
+type Container struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// ContainerStatus holds the value of the "container_status" field.
+	ContainerStatus property.ContainerStatus `json:"container_status,omitempty"`
+	// ContainerID holds the value of the "container_id" field.
+	ContainerID *string `json:"container_id,omitempty"`
+	// StatusMessage holds the value of the "status_message" field.
+	StatusMessage *string `json:"status_message,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// ExitTime holds the value of the "exit_time" field.
+	ExitTime *time.Time `json:"exit_time,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContainerQuery when eager-loading is set.
+	Edges                ContainerEdges `json:"edges"`
+	image_containers     *int
+	workspace_containers *int
+	selectValues         sql.SelectValues
+}
+
+// ContainerEdges holds the relations/edges for other nodes in the graph.
+type ContainerEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Image holds the value of the image edge.
+	Image *Image `json:"image,omitempty"`
+	// Workspace holds the value of the workspace edge.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContainerEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ImageOrErr returns the Image value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContainerEdges) ImageOrErr() (*Image, error) {
+	if e.Image != nil {
+		return e.Image, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: image.Label}
+	}
+	return nil, &NotLoadedError{edge: "image"}
+}
+
+// WorkspaceOrErr returns the Workspace value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContainerEdges) WorkspaceOrErr() (*Workspace, error) {
+	if e.Workspace != nil {
+		return e.Workspace, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: workspace.Label}
+	}
+	return nil, &NotLoadedError{edge: "workspace"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Container) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case container.FieldID, container.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case container.FieldContainerStatus, container.FieldContainerID, container.FieldStatusMessage:
+			values[i] = new(sql.NullString)
+		case container.FieldCreateTime, container.FieldExitTime:
+			values[i] = new(sql.NullTime)
+		case container.ForeignKeys[0]: // image_containers
+			values[i] = new(sql.NullInt64)
+		case container.ForeignKeys[1]: // workspace_containers
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Container fields.
+func (_m *Container) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case container.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case container.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case container.FieldContainerStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field container_status", values[i])
+			} else if value.Valid {
+				_m.ContainerStatus = property.ContainerStatus(value.String)
+			}
+		case container.FieldContainerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field container_id", values[i])
+			} else if value.Valid {
+				_m.ContainerID = new(string)
+				*_m.ContainerID = value.String
+			}
+		case container.FieldStatusMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_message", values[i])
+			} else if value.Valid {
+				_m.StatusMessage = new(string)
+				*_m.StatusMessage = value.String
+			}
+		case container.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case container.FieldExitTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field exit_time", values[i])
+			} else if value.Valid {
+				_m.ExitTime = new(time.Time)
+				*_m.ExitTime = value.Time
+			}
+		case container.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field image_containers", value)
+			} else if value.Valid {
+				_m.image_containers = new(int)
+				*_m.image_containers = int(value.Int64)
+			}
+		case container.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field workspace_containers", value)
+			} else if value.Valid {
+				_m.workspace_containers = new(int)
+				*_m.workspace_containers = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Container.
+// This includes values selected through modifiers, order, etc.
+func (_m *Container) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Container entity.
+func (_m *Container) QueryUser() *UserQuery {
+	return NewContainerClient(_m.config).QueryUser(_m)
+}
+
+// QueryImage queries the "image" edge of the Container entity.
+func (_m *Container) QueryImage() *ImageQuery {
+	return NewContainerClient(_m.config).QueryImage(_m)
+}
+
+// QueryWorkspace queries the "workspace" edge of the Container entity.
+func (_m *Container) QueryWorkspace() *WorkspaceQuery {
+	return NewContainerClient(_m.config).QueryWorkspace(_m)
+}
+
+// Update returns a builder for updating this Container.
+// Note that you need to call Container.Unwrap() before calling this method if this Container
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Container) Update() *ContainerUpdateOne {
+	return NewContainerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Container entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Container) Unwrap() *Container {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Container is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Container) String() string {
+	var builder strings.Builder
+	builder.WriteString("Container(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("container_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ContainerStatus))
+	builder.WriteString(", ")
+	if v := _m.ContainerID; v != nil {
+		builder.WriteString("container_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.StatusMessage; v != nil {
+		builder.WriteString("status_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ExitTime; v != nil {
+		builder.WriteString("exit_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Containers is a parsable slice of Container.
+type Containers []*Container
@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package container
+
+import (
+	"liteide-backend/ent/predicate"
+	"liteide-backend/ent/property"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Container {
+	return predicate.Container(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Container {
+	return predicate.Container(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Container {
+	return predicate.Container(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Container {
+	return predicate.Container(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Container {
+	return predicate.Container(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Container {
+	return predicate.Container(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Container {
+	return predicate.Container(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldUserID, v))
+}
+
+// ContainerID applies equality check predicate on the "container_id" field. It's identical to ContainerIDEQ.
+func ContainerID(v string) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldContainerID, v))
+}
+
+// StatusMessage applies equality check predicate on the "status_message" field. It's identical to StatusMessageEQ.
+func StatusMessage(v string) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldStatusMessage, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// ExitTime applies equality check predicate on the "exit_time" field. It's identical to ExitTimeEQ.
+func ExitTime(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldExitTime, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Container {
+	return predicate.Container(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Container {
+	return predicate.Container(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Container {
+	return predicate.Container(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Container {
+	return predicate.Container(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Container {
+	return predicate.Container(sql.FieldNotNull(FieldUserID))
+}
+
+// ContainerStatusEQ applies the EQ predicate on the "container_status" field.
+func ContainerStatusEQ(v property.ContainerStatus) predicate.Container {
+	vc := v
+	return predicate.Container(sql.FieldEQ(FieldContainerStatus, vc))
+}
+
+// ContainerStatusNEQ applies the NEQ predicate on the "container_status" field.
+func ContainerStatusNEQ(v property.ContainerStatus) predicate.Container {
+	vc := v
+	return predicate.Container(sql.FieldNEQ(FieldContainerStatus, vc))
+}
+
+// ContainerStatusIn applies the In predicate on the "container_status" field.
+func ContainerStatusIn(vs ...property.ContainerStatus) predicate.Container {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Container(sql.FieldIn(FieldContainerStatus, v...))
+}
+
+// ContainerStatusNotIn applies the NotIn predicate on the "container_status" field.
+func ContainerStatusNotIn(vs ...property.ContainerStatus) predicate.Container {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Container(sql.FieldNotIn(FieldContainerStatus, v...))
+}
+
+// ContainerIDEQ applies the EQ predicate on the "container_id" field.
+func ContainerIDEQ(v string) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldContainerID, v))
+}
+
+// ContainerIDNEQ applies the NEQ predicate on the "container_id" field.
+func ContainerIDNEQ(v string) predicate.Container {
+	return predicate.Container(sql.FieldNEQ(FieldContainerID, v))
+}
+
+// ContainerIDIn applies the In predicate on the "container_id" field.
+func ContainerIDIn(vs ...string) predicate.Container {
+	return predicate.Container(sql.FieldIn(FieldContainerID, vs...))
+}
+
+// ContainerIDNotIn applies the NotIn predicate on the "container_id" field.
+func ContainerIDNotIn(vs ...string) predicate.Container {
+	return predicate.Container(sql.FieldNotIn(FieldContainerID, vs...))
+}
+
+// ContainerIDGT applies the GT predicate on the "container_id" field.
+func ContainerIDGT(v string) predicate.Container {
+	return predicate.Container(sql.FieldGT(FieldContainerID, v))
+}
+
+// ContainerIDGTE applies the GTE predicate on the "container_id" field.
+func ContainerIDGTE(v string) predicate.Container {
+	return predicate.Container(sql.FieldGTE(FieldContainerID, v))
+}
+
+// ContainerIDLT applies the LT predicate on the "container_id" field.
+func ContainerIDLT(v string) predicate.Container {
+	return predicate.Container(sql.FieldLT(FieldContainerID, v))
+}
+
+// ContainerIDLTE applies the LTE predicate on the "container_id" field.
+func ContainerIDLTE(v string) predicate.Container {
+	return predicate.Container(sql.FieldLTE(FieldContainerID, v))
+}
+
+// ContainerIDContains applies the Contains predicate on the "container_id" field.
+func ContainerIDContains(v string) predicate.Container {
+	return predicate.Container(sql.FieldContains(FieldContainerID, v))
+}
+
+// ContainerIDHasPrefix applies the HasPrefix predicate on the "container_id" field.
+func ContainerIDHasPrefix(v string) predicate.Container {
+	return predicate.Container(sql.FieldHasPrefix(FieldContainerID, v))
+}
+
+// ContainerIDHasSuffix applies the HasSuffix predicate on the "container_id" field.
+func ContainerIDHasSuffix(v string) predicate.Container {
+	return predicate.Container(sql.FieldHasSuffix(FieldContainerID, v))
+}
+
+// ContainerIDIsNil applies the IsNil predicate on the "container_id" field.
+func ContainerIDIsNil() predicate.Container {
+	return predicate.Container(sql.FieldIsNull(FieldContainerID))
+}
+
+// ContainerIDNotNil applies the NotNil predicate on the "container_id" field.
+func ContainerIDNotNil() predicate.Container {
+	return predicate.Container(sql.FieldNotNull(FieldContainerID))
+}
+
+// ContainerIDEqualFold applies the EqualFold predicate on the "container_id" field.
+func ContainerIDEqualFold(v string) predicate.Container {
+	return predicate.Container(sql.FieldEqualFold(FieldContainerID, v))
+}
+
+// ContainerIDContainsFold applies the ContainsFold predicate on the "container_id" field.
+func ContainerIDContainsFold(v string) predicate.Container {
+	return predicate.Container(sql.FieldContainsFold(FieldContainerID, v))
+}
+
+// StatusMessageEQ applies the EQ predicate on the "status_message" field.
+func StatusMessageEQ(v string) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldStatusMessage, v))
+}
+
+// StatusMessageNEQ applies the NEQ predicate on the "status_message" field.
+func StatusMessageNEQ(v string) predicate.Container {
+	return predicate.Container(sql.FieldNEQ(FieldStatusMessage, v))
+}
+
+// StatusMessageIn applies the In predicate on the "status_message" field.
+func StatusMessageIn(vs ...string) predicate.Container {
+	return predicate.Container(sql.FieldIn(FieldStatusMessage, vs...))
+}
+
+// StatusMessageNotIn applies the NotIn predicate on the "status_message" field.
+func StatusMessageNotIn(vs ...string) predicate.Container {
+	return predicate.Container(sql.FieldNotIn(FieldStatusMessage, vs...))
+}
+
+// StatusMessageGT applies the GT predicate on the "status_message" field.
+func StatusMessageGT(v string) predicate.Container {
+	return predicate.Container(sql.FieldGT(FieldStatusMessage, v))
+}
+
+// StatusMessageGTE applies the GTE predicate on the "status_message" field.
+func StatusMessageGTE(v string) predicate.Container {
+	return predicate.Container(sql.FieldGTE(FieldStatusMessage, v))
+}
+
+// StatusMessageLT applies the LT predicate on the "status_message" field.
+func StatusMessageLT(v string) predicate.Container {
+	return predicate.Container(sql.FieldLT(FieldStatusMessage, v))
+}
+
+// StatusMessageLTE applies the LTE predicate on the "status_message" field.
+func StatusMessageLTE(v string) predicate.Container {
+	return predicate.Container(sql.FieldLTE(FieldStatusMessage, v))
+}
+
+// StatusMessageContains applies the Contains predicate on the "status_message" field.
+func StatusMessageContains(v string) predicate.Container {
+	return predicate.Container(sql.FieldContains(FieldStatusMessage, v))
+}
+
+// StatusMessageHasPrefix applies the HasPrefix predicate on the "status_message" field.
+func StatusMessageHasPrefix(v string) predicate.Container {
+	return predicate.Container(sql.FieldHasPrefix(FieldStatusMessage, v))
+}
+
+// StatusMessageHasSuffix applies the HasSuffix predicate on the "status_message" field.
+func StatusMessageHasSuffix(v string) predicate.Container {
+	return predicate.Container(sql.FieldHasSuffix(FieldStatusMessage, v))
+}
+
+// StatusMessageIsNil applies the IsNil predicate on the "status_message" field.
+func StatusMessageIsNil() predicate.Container {
+	return predicate.Container(sql.FieldIsNull(FieldStatusMessage))
+}
+
+// StatusMessageNotNil applies the NotNil predicate on the "status_message" field.
+func StatusMessageNotNil() predicate.Container {
+	return predicate.Container(sql.FieldNotNull(FieldStatusMessage))
+}
+
+// StatusMessageEqualFold applies the EqualFold predicate on the "status_message" field.
+func StatusMessageEqualFold(v string) predicate.Container {
+	return predicate.Container(sql.FieldEqualFold(FieldStatusMessage, v))
+}
+
+// StatusMessageContainsFold applies the ContainsFold predicate on the "status_message" field.
+func StatusMessageContainsFold(v string) predicate.Container {
+	return predicate.Container(sql.FieldContainsFold(FieldStatusMessage, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Container {
+	return predicate.Container(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Container {
+	return predicate.Container(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// ExitTimeEQ applies the EQ predicate on the "exit_time" field.
+func ExitTimeEQ(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldEQ(FieldExitTime, v))
+}
+
+// ExitTimeNEQ applies the NEQ predicate on the "exit_time" field.
+func ExitTimeNEQ(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldNEQ(FieldExitTime, v))
+}
+
+// ExitTimeIn applies the In predicate on the "exit_time" field.
+func ExitTimeIn(vs ...time.Time) predicate.Container {
+	return predicate.Container(sql.FieldIn(FieldExitTime, vs...))
+}
+
+// ExitTimeNotIn applies the NotIn predicate on the "exit_time" field.
+func ExitTimeNotIn(vs ...time.Time) predicate.Container {
+	return predicate.Container(sql.FieldNotIn(FieldExitTime, vs...))
+}
+
+// ExitTimeGT applies the GT predicate on the "exit_time" field.
+func ExitTimeGT(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldGT(FieldExitTime, v))
+}
+
+// ExitTimeGTE applies the GTE predicate on the "exit_time" field.
+func ExitTimeGTE(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldGTE(FieldExitTime, v))
+}
+
+// ExitTimeLT applies the LT predicate on the "exit_time" field.
+func ExitTimeLT(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldLT(FieldExitTime, v))
+}
+
+// ExitTimeLTE applies the LTE predicate on the "exit_time" field.
+func ExitTimeLTE(v time.Time) predicate.Container {
+	return predicate.Container(sql.FieldLTE(FieldExitTime, v))
+}
+
+// ExitTimeIsNil applies the IsNil predicate on the "exit_time" field.
+func ExitTimeIsNil() predicate.Container {
+	return predicate.Container(sql.FieldIsNull(FieldExitTime))
+}
+
+// ExitTimeNotNil applies the NotNil predicate on the "exit_time" field.
+func ExitTimeNotNil() predicate.Container {
+	return predicate.Container(sql.FieldNotNull(FieldExitTime))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Container {
+	return predicate.Container(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Container {
+	return predicate.Container(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImage applies the HasEdge predicate on the "image" edge.
+func HasImage() predicate.Container {
+	return predicate.Container(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ImageTable, ImageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImageWith applies the HasEdge predicate on the "image" edge with a given conditions (other predicates).
+func HasImageWith(preds ...predicate.Image) predicate.Container {
+	return predicate.Container(func(s *sql.Selector) {
+		step := newImageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkspace applies the HasEdge predicate on the "workspace" edge.
+func HasWorkspace() predicate.Container {
+	return predicate.Container(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkspaceTable, WorkspaceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkspaceWith applies the HasEdge predicate on the "workspace" edge with a given conditions (other predicates).
+func HasWorkspaceWith(preds ...predicate.Workspace) predicate.Container {
+	return predicate.Container(func(s *sql.Selector) {
+		step := newWorkspaceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Container) predicate.Container {
+	return predicate.Container(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Container) predicate.Container {
+	return predicate.Container(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Container) predicate.Container {
+	return predicate.Container(sql.NotPredicates(p))
+}
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/user"
+	"liteide-backend/ent/workspace"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContainerCreate is the builder for creating a Container entity.
+type ContainerCreate struct {
+	config
+	mutation *ContainerMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ContainerCreate) SetUserID(v int) *ContainerCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *ContainerCreate) SetNillableUserID(v *int) *ContainerCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetContainerStatus sets the "container_status" field.
+func (_c *ContainerCreate) SetContainerStatus(v property.ContainerStatus) *ContainerCreate {
+	_c.mutation.SetContainerStatus(v)
+	return _c
+}
+
+// SetNillableContainerStatus sets the "container_status" field if the given value is not nil.
+func (_c *ContainerCreate) SetNillableContainerStatus(v *property.ContainerStatus) *ContainerCreate {
+	if v != nil {
+		_c.SetContainerStatus(*v)
+	}
+	return _c
+}
+
+// SetContainerID sets the "container_id" field.
+func (_c *ContainerCreate) SetContainerID(v string) *ContainerCreate {
+	_c.mutation.SetContainerID(v)
+	return _c
+}
+
+// SetNillableContainerID sets the "container_id" field if the given value is not nil.
+func (_c *ContainerCreate) SetNillableContainerID(v *string) *ContainerCreate {
+	if v != nil {
+		_c.SetContainerID(*v)
+	}
+	return _c
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_c *ContainerCreate) SetStatusMessage(v string) *ContainerCreate {
+	_c.mutation.SetStatusMessage(v)
+	return _c
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_c *ContainerCreate) SetNillableStatusMessage(v *string) *ContainerCreate {
+	if v != nil {
+		_c.SetStatusMessage(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ContainerCreate) SetCreateTime(v time.Time) *ContainerCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ContainerCreate) SetNillableCreateTime(v *time.Time) *ContainerCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetExitTime sets the "exit_time" field.
+func (_c *ContainerCreate) SetExitTime(v time.Time) *ContainerCreate {
+	_c.mutation.SetExitTime(v)
+	return _c
+}
+
+// SetNillableExitTime sets the "exit_time" field if the given value is not nil.
+func (_c *ContainerCreate) SetNillableExitTime(v *time.Time) *ContainerCreate {
+	if v != nil {
+		_c.SetExitTime(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ContainerCreate) SetUser(v *User) *ContainerCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetImageID sets the "image" edge to the Image entity by ID.
+func (_c *ContainerCreate) SetImageID(id int) *ContainerCreate {
+	_c.mutation.SetImageID(id)
+	return _c
+}
+
+// SetImage sets the "image" edge to the Image entity.
+func (_c *ContainerCreate) SetImage(v *Image) *ContainerCreate {
+	return _c.SetImageID(v.ID)
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (_c *ContainerCreate) SetWorkspaceID(id int) *ContainerCreate {
+	_c.mutation.SetWorkspaceID(id)
+	return _c
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_c *ContainerCreate) SetWorkspace(v *Workspace) *ContainerCreate {
+	return _c.SetWorkspaceID(v.ID)
+}
+
+// Mutation returns the ContainerMutation object of the builder.
+func (_c *ContainerCreate) Mutation() *ContainerMutation {
+	return _c.mutation
+}
+
+// Save creates the Container in the database.
+func (_c *ContainerCreate) Save(ctx context.Context) (*Container, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContainerCreate) SaveX(ctx context.Context) *Container {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContainerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContainerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContainerCreate) defaults() {
+	if _, ok := _c.mutation.ContainerStatus(); !ok {
+		v := container.DefaultContainerStatus
+		_c.mutation.SetContainerStatus(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := container.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContainerCreate) check() error {
+	if _, ok := _c.mutation.ContainerStatus(); !ok {
+		return &ValidationError{Name: "container_status", err: errors.New(`ent: missing required field "Container.container_status"`)}
+	}
+	if v, ok := _c.mutation.ContainerStatus(); ok {
+		if err := container.ContainerStatusValidator(v); err != nil {
+			return &ValidationError{Name: "container_status", err: fmt.Errorf(`ent: validator failed for field "Container.container_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Container.create_time"`)}
+	}
+	if len(_c.mutation.ImageIDs()) == 0 {
+		return &ValidationError{Name: "image", err: errors.New(`ent: missing required edge "Container.image"`)}
+	}
+	if len(_c.mutation.WorkspaceIDs()) == 0 {
+		return &ValidationError{Name: "workspace", err: errors.New(`ent: missing required edge "Container.workspace"`)}
+	}
+	return nil
+}
+
+func (_c *ContainerCreate) sqlSave(ctx context.Context) (*Container, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContainerCreate) createSpec() (*Container, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Container{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(container.Table, sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ContainerStatus(); ok {
+		_spec.SetField(container.FieldContainerStatus, field.TypeEnum, value)
+		_node.ContainerStatus = value
+	}
+	if value, ok := _c.mutation.ContainerID(); ok {
+		_spec.SetField(container.FieldContainerID, field.TypeString, value)
+		_node.ContainerID = &value
+	}
+	if value, ok := _c.mutation.StatusMessage(); ok {
+		_spec.SetField(container.FieldStatusMessage, field.TypeString, value)
+		_node.StatusMessage = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(container.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.ExitTime(); ok {
+		_spec.SetField(container.FieldExitTime, field.TypeTime, value)
+		_node.ExitTime = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.UserTable,
+			Columns: []string{container.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.ImageTable,
+			Columns: []string{container.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.image_containers = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.WorkspaceTable,
+			Columns: []string{container.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.workspace_containers = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContainerCreateBulk is the builder for creating many Container entities in bulk.
+type ContainerCreateBulk struct {
+	config
+	err      error
+	builders []*ContainerCreate
+}
+
+// Save creates the Container entities in the database.
+func (_c *ContainerCreateBulk) Save(ctx context.Context) ([]*Container, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Container, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContainerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContainerCreateBulk) SaveX(ctx context.Context) []*Container {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContainerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContainerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
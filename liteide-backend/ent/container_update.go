@@ -0,0 +1,712 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/predicate"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/user"
+	"liteide-backend/ent/workspace"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ContainerUpdate is the builder for updating Container entities.
+type ContainerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContainerMutation
+}
+
+// Where appends a list predicates to the ContainerUpdate builder.
+func (_u *ContainerUpdate) Where(ps ...predicate.Container) *ContainerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ContainerUpdate) SetUserID(v int) *ContainerUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ContainerUpdate) SetNillableUserID(v *int) *ContainerUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ContainerUpdate) ClearUserID() *ContainerUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetContainerStatus sets the "container_status" field.
+func (_u *ContainerUpdate) SetContainerStatus(v property.ContainerStatus) *ContainerUpdate {
+	_u.mutation.SetContainerStatus(v)
+	return _u
+}
+
+// SetNillableContainerStatus sets the "container_status" field if the given value is not nil.
+func (_u *ContainerUpdate) SetNillableContainerStatus(v *property.ContainerStatus) *ContainerUpdate {
+	if v != nil {
+		_u.SetContainerStatus(*v)
+	}
+	return _u
+}
+
+// SetContainerID sets the "container_id" field.
+func (_u *ContainerUpdate) SetContainerID(v string) *ContainerUpdate {
+	_u.mutation.SetContainerID(v)
+	return _u
+}
+
+// SetNillableContainerID sets the "container_id" field if the given value is not nil.
+func (_u *ContainerUpdate) SetNillableContainerID(v *string) *ContainerUpdate {
+	if v != nil {
+		_u.SetContainerID(*v)
+	}
+	return _u
+}
+
+// ClearContainerID clears the value of the "container_id" field.
+func (_u *ContainerUpdate) ClearContainerID() *ContainerUpdate {
+	_u.mutation.ClearContainerID()
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *ContainerUpdate) SetStatusMessage(v string) *ContainerUpdate {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *ContainerUpdate) SetNillableStatusMessage(v *string) *ContainerUpdate {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *ContainerUpdate) ClearStatusMessage() *ContainerUpdate {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetExitTime sets the "exit_time" field.
+func (_u *ContainerUpdate) SetExitTime(v time.Time) *ContainerUpdate {
+	_u.mutation.SetExitTime(v)
+	return _u
+}
+
+// SetNillableExitTime sets the "exit_time" field if the given value is not nil.
+func (_u *ContainerUpdate) SetNillableExitTime(v *time.Time) *ContainerUpdate {
+	if v != nil {
+		_u.SetExitTime(*v)
+	}
+	return _u
+}
+
+// ClearExitTime clears the value of the "exit_time" field.
+func (_u *ContainerUpdate) ClearExitTime() *ContainerUpdate {
+	_u.mutation.ClearExitTime()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ContainerUpdate) SetUser(v *User) *ContainerUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetImageID sets the "image" edge to the Image entity by ID.
+func (_u *ContainerUpdate) SetImageID(id int) *ContainerUpdate {
+	_u.mutation.SetImageID(id)
+	return _u
+}
+
+// SetImage sets the "image" edge to the Image entity.
+func (_u *ContainerUpdate) SetImage(v *Image) *ContainerUpdate {
+	return _u.SetImageID(v.ID)
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (_u *ContainerUpdate) SetWorkspaceID(id int) *ContainerUpdate {
+	_u.mutation.SetWorkspaceID(id)
+	return _u
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_u *ContainerUpdate) SetWorkspace(v *Workspace) *ContainerUpdate {
+	return _u.SetWorkspaceID(v.ID)
+}
+
+// Mutation returns the ContainerMutation object of the builder.
+func (_u *ContainerUpdate) Mutation() *ContainerMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ContainerUpdate) ClearUser() *ContainerUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearImage clears the "image" edge to the Image entity.
+func (_u *ContainerUpdate) ClearImage() *ContainerUpdate {
+	_u.mutation.ClearImage()
+	return _u
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (_u *ContainerUpdate) ClearWorkspace() *ContainerUpdate {
+	_u.mutation.ClearWorkspace()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContainerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContainerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContainerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContainerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContainerUpdate) check() error {
+	if v, ok := _u.mutation.ContainerStatus(); ok {
+		if err := container.ContainerStatusValidator(v); err != nil {
+			return &ValidationError{Name: "container_status", err: fmt.Errorf(`ent: validator failed for field "Container.container_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ImageCleared() && len(_u.mutation.ImageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Container.image"`)
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Container.workspace"`)
+	}
+	return nil
+}
+
+func (_u *ContainerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(container.Table, container.Columns, sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ContainerStatus(); ok {
+		_spec.SetField(container.FieldContainerStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ContainerID(); ok {
+		_spec.SetField(container.FieldContainerID, field.TypeString, value)
+	}
+	if _u.mutation.ContainerIDCleared() {
+		_spec.ClearField(container.FieldContainerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(container.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(container.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExitTime(); ok {
+		_spec.SetField(container.FieldExitTime, field.TypeTime, value)
+	}
+	if _u.mutation.ExitTimeCleared() {
+		_spec.ClearField(container.FieldExitTime, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.UserTable,
+			Columns: []string{container.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.UserTable,
+			Columns: []string{container.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.ImageTable,
+			Columns: []string{container.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.ImageTable,
+			Columns: []string{container.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.WorkspaceTable,
+			Columns: []string{container.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.WorkspaceTable,
+			Columns: []string{container.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{container.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContainerUpdateOne is the builder for updating a single Container entity.
+type ContainerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContainerMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ContainerUpdateOne) SetUserID(v int) *ContainerUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ContainerUpdateOne) SetNillableUserID(v *int) *ContainerUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ContainerUpdateOne) ClearUserID() *ContainerUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetContainerStatus sets the "container_status" field.
+func (_u *ContainerUpdateOne) SetContainerStatus(v property.ContainerStatus) *ContainerUpdateOne {
+	_u.mutation.SetContainerStatus(v)
+	return _u
+}
+
+// SetNillableContainerStatus sets the "container_status" field if the given value is not nil.
+func (_u *ContainerUpdateOne) SetNillableContainerStatus(v *property.ContainerStatus) *ContainerUpdateOne {
+	if v != nil {
+		_u.SetContainerStatus(*v)
+	}
+	return _u
+}
+
+// SetContainerID sets the "container_id" field.
+func (_u *ContainerUpdateOne) SetContainerID(v string) *ContainerUpdateOne {
+	_u.mutation.SetContainerID(v)
+	return _u
+}
+
+// SetNillableContainerID sets the "container_id" field if the given value is not nil.
+func (_u *ContainerUpdateOne) SetNillableContainerID(v *string) *ContainerUpdateOne {
+	if v != nil {
+		_u.SetContainerID(*v)
+	}
+	return _u
+}
+
+// ClearContainerID clears the value of the "container_id" field.
+func (_u *ContainerUpdateOne) ClearContainerID() *ContainerUpdateOne {
+	_u.mutation.ClearContainerID()
+	return _u
+}
+
+// SetStatusMessage sets the "status_message" field.
+func (_u *ContainerUpdateOne) SetStatusMessage(v string) *ContainerUpdateOne {
+	_u.mutation.SetStatusMessage(v)
+	return _u
+}
+
+// SetNillableStatusMessage sets the "status_message" field if the given value is not nil.
+func (_u *ContainerUpdateOne) SetNillableStatusMessage(v *string) *ContainerUpdateOne {
+	if v != nil {
+		_u.SetStatusMessage(*v)
+	}
+	return _u
+}
+
+// ClearStatusMessage clears the value of the "status_message" field.
+func (_u *ContainerUpdateOne) ClearStatusMessage() *ContainerUpdateOne {
+	_u.mutation.ClearStatusMessage()
+	return _u
+}
+
+// SetExitTime sets the "exit_time" field.
+func (_u *ContainerUpdateOne) SetExitTime(v time.Time) *ContainerUpdateOne {
+	_u.mutation.SetExitTime(v)
+	return _u
+}
+
+// SetNillableExitTime sets the "exit_time" field if the given value is not nil.
+func (_u *ContainerUpdateOne) SetNillableExitTime(v *time.Time) *ContainerUpdateOne {
+	if v != nil {
+		_u.SetExitTime(*v)
+	}
+	return _u
+}
+
+// ClearExitTime clears the value of the "exit_time" field.
+func (_u *ContainerUpdateOne) ClearExitTime() *ContainerUpdateOne {
+	_u.mutation.ClearExitTime()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ContainerUpdateOne) SetUser(v *User) *ContainerUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetImageID sets the "image" edge to the Image entity by ID.
+func (_u *ContainerUpdateOne) SetImageID(id int) *ContainerUpdateOne {
+	_u.mutation.SetImageID(id)
+	return _u
+}
+
+// SetImage sets the "image" edge to the Image entity.
+func (_u *ContainerUpdateOne) SetImage(v *Image) *ContainerUpdateOne {
+	return _u.SetImageID(v.ID)
+}
+
+// SetWorkspaceID sets the "workspace" edge to the Workspace entity by ID.
+func (_u *ContainerUpdateOne) SetWorkspaceID(id int) *ContainerUpdateOne {
+	_u.mutation.SetWorkspaceID(id)
+	return _u
+}
+
+// SetWorkspace sets the "workspace" edge to the Workspace entity.
+func (_u *ContainerUpdateOne) SetWorkspace(v *Workspace) *ContainerUpdateOne {
+	return _u.SetWorkspaceID(v.ID)
+}
+
+// Mutation returns the ContainerMutation object of the builder.
+func (_u *ContainerUpdateOne) Mutation() *ContainerMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ContainerUpdateOne) ClearUser() *ContainerUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearImage clears the "image" edge to the Image entity.
+func (_u *ContainerUpdateOne) ClearImage() *ContainerUpdateOne {
+	_u.mutation.ClearImage()
+	return _u
+}
+
+// ClearWorkspace clears the "workspace" edge to the Workspace entity.
+func (_u *ContainerUpdateOne) ClearWorkspace() *ContainerUpdateOne {
+	_u.mutation.ClearWorkspace()
+	return _u
+}
+
+// Where appends a list predicates to the ContainerUpdate builder.
+func (_u *ContainerUpdateOne) Where(ps ...predicate.Container) *ContainerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContainerUpdateOne) Select(field string, fields ...string) *ContainerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Container entity.
+func (_u *ContainerUpdateOne) Save(ctx context.Context) (*Container, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContainerUpdateOne) SaveX(ctx context.Context) *Container {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContainerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContainerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContainerUpdateOne) check() error {
+	if v, ok := _u.mutation.ContainerStatus(); ok {
+		if err := container.ContainerStatusValidator(v); err != nil {
+			return &ValidationError{Name: "container_status", err: fmt.Errorf(`ent: validator failed for field "Container.container_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ImageCleared() && len(_u.mutation.ImageIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Container.image"`)
+	}
+	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Container.workspace"`)
+	}
+	return nil
+}
+
+func (_u *ContainerUpdateOne) sqlSave(ctx context.Context) (_node *Container, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(container.Table, container.Columns, sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Container.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, container.FieldID)
+		for _, f := range fields {
+			if !container.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != container.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ContainerStatus(); ok {
+		_spec.SetField(container.FieldContainerStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ContainerID(); ok {
+		_spec.SetField(container.FieldContainerID, field.TypeString, value)
+	}
+	if _u.mutation.ContainerIDCleared() {
+		_spec.ClearField(container.FieldContainerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StatusMessage(); ok {
+		_spec.SetField(container.FieldStatusMessage, field.TypeString, value)
+	}
+	if _u.mutation.StatusMessageCleared() {
+		_spec.ClearField(container.FieldStatusMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExitTime(); ok {
+		_spec.SetField(container.FieldExitTime, field.TypeTime, value)
+	}
+	if _u.mutation.ExitTimeCleared() {
+		_spec.ClearField(container.FieldExitTime, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.UserTable,
+			Columns: []string{container.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.UserTable,
+			Columns: []string{container.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.ImageTable,
+			Columns: []string{container.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.ImageTable,
+			Columns: []string{container.ImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WorkspaceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.WorkspaceTable,
+			Columns: []string{container.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WorkspaceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   container.WorkspaceTable,
+			Columns: []string{container.WorkspaceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Container{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{container.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/property"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Image is the model entity for the Image schema.
+type Image struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ImageName holds the value of the "image_name" field.
+	ImageName string `json:"image_name,omitempty"`
+	// Digest holds the value of the "digest" field.
+	Digest *string `json:"digest,omitempty"`
+	// Language holds the value of the "language" field.
+	Language property.Language `json:"language,omitempty"`
+	// NanoCpus holds the value of the "nano_cpus" field.
+	NanoCpus *int64 `json:"nano_cpus,omitempty"`
+	// MemoryBytes holds the value of the "memory_bytes" field.
+	MemoryBytes *int64 `json:"memory_bytes,omitempty"`
+	// PidsLimit holds the value of the "pids_limit" field.
+	PidsLimit *int64 `json:"pids_limit,omitempty"`
+	// NetworkMode holds the value of the "network_mode" field.
+	NetworkMode *property.NetworkMode `json:"network_mode,omitempty"`
+	// PullStatus holds the value of the "pull_status" field.
+	PullStatus property.ImagePullStatus `json:"pull_status,omitempty"`
+	// PullMessage holds the value of the "pull_message" field.
+	PullMessage *string `json:"pull_message,omitempty"`
+	// PullTime holds the value of the "pull_time" field.
+	PullTime *time.Time `json:"pull_time,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImageQuery when eager-loading is set.
+	Edges        ImageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImageEdges holds the relations/edges for other nodes in the graph.
+type ImageEdges struct {
+	// Containers holds the value of the containers edge.
+	Containers []*Container `json:"containers,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ContainersOrErr returns the Containers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ImageEdges) ContainersOrErr() ([]*Container, error) {
+	if e.loadedTypes[0] {
+		return e.Containers, nil
+	}
+	return nil, &NotLoadedError{edge: "containers"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Image) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case image.FieldID, image.FieldNanoCpus, image.FieldMemoryBytes, image.FieldPidsLimit:
+			values[i] = new(sql.NullInt64)
+		case image.FieldImageName, image.FieldDigest, image.FieldLanguage, image.FieldNetworkMode, image.FieldPullStatus, image.FieldPullMessage:
+			values[i] = new(sql.NullString)
+		case image.FieldPullTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Image fields.
+func (_m *Image) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case image.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case image.FieldImageName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_name", values[i])
+			} else if value.Valid {
+				_m.ImageName = value.String
+			}
+		case image.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				_m.Digest = new(string)
+				*_m.Digest = value.String
+			}
+		case image.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = property.Language(value.String)
+			}
+		case image.FieldNanoCpus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nano_cpus", values[i])
+			} else if value.Valid {
+				_m.NanoCpus = new(int64)
+				*_m.NanoCpus = value.Int64
+			}
+		case image.FieldMemoryBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_bytes", values[i])
+			} else if value.Valid {
+				_m.MemoryBytes = new(int64)
+				*_m.MemoryBytes = value.Int64
+			}
+		case image.FieldPidsLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pids_limit", values[i])
+			} else if value.Valid {
+				_m.PidsLimit = new(int64)
+				*_m.PidsLimit = value.Int64
+			}
+		case image.FieldNetworkMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_mode", values[i])
+			} else if value.Valid {
+				_m.NetworkMode = new(property.NetworkMode)
+				*_m.NetworkMode = property.NetworkMode(value.String)
+			}
+		case image.FieldPullStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pull_status", values[i])
+			} else if value.Valid {
+				_m.PullStatus = property.ImagePullStatus(value.String)
+			}
+		case image.FieldPullMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pull_message", values[i])
+			} else if value.Valid {
+				_m.PullMessage = new(string)
+				*_m.PullMessage = value.String
+			}
+		case image.FieldPullTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field pull_time", values[i])
+			} else if value.Valid {
+				_m.PullTime = new(time.Time)
+				*_m.PullTime = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Image.
+// This includes values selected through modifiers, order, etc.
+func (_m *Image) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryContainers queries the "containers" edge of the Image entity.
+func (_m *Image) QueryContainers() *ContainerQuery {
+	return NewImageClient(_m.config).QueryContainers(_m)
+}
+
+// Update returns a builder for updating this Image.
+// Note that you need to call Image.Unwrap() before calling this method if this Image
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Image) Update() *ImageUpdateOne {
+	return NewImageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Image entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Image) Unwrap() *Image {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Image is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Image) String() string {
+	var builder strings.Builder
+	builder.WriteString("Image(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("image_name=")
+	builder.WriteString(_m.ImageName)
+	builder.WriteString(", ")
+	if v := _m.Digest; v != nil {
+		builder.WriteString("digest=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Language))
+	builder.WriteString(", ")
+	if v := _m.NanoCpus; v != nil {
+		builder.WriteString("nano_cpus=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MemoryBytes; v != nil {
+		builder.WriteString("memory_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PidsLimit; v != nil {
+		builder.WriteString("pids_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.NetworkMode; v != nil {
+		builder.WriteString("network_mode=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("pull_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PullStatus))
+	builder.WriteString(", ")
+	if v := _m.PullMessage; v != nil {
+		builder.WriteString("pull_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PullTime; v != nil {
+		builder.WriteString("pull_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Images is a parsable slice of Image.
+type Images []*Image
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"fmt"
+	"liteide-backend/ent/property"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the image type in the database.
+	Label = "image"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldImageName holds the string denoting the image_name field in the database.
+	FieldImageName = "image_name"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldNanoCpus holds the string denoting the nano_cpus field in the database.
+	FieldNanoCpus = "nano_cpus"
+	// FieldMemoryBytes holds the string denoting the memory_bytes field in the database.
+	FieldMemoryBytes = "memory_bytes"
+	// FieldPidsLimit holds the string denoting the pids_limit field in the database.
+	FieldPidsLimit = "pids_limit"
+	// FieldNetworkMode holds the string denoting the network_mode field in the database.
+	FieldNetworkMode = "network_mode"
+	// FieldPullStatus holds the string denoting the pull_status field in the database.
+	FieldPullStatus = "pull_status"
+	// FieldPullMessage holds the string denoting the pull_message field in the database.
+	FieldPullMessage = "pull_message"
+	// FieldPullTime holds the string denoting the pull_time field in the database.
+	FieldPullTime = "pull_time"
+	// EdgeContainers holds the string denoting the containers edge name in mutations.
+	EdgeContainers = "containers"
+	// Table holds the table name of the image in the database.
+	Table = "images"
+	// ContainersTable is the table that holds the containers relation/edge.
+	ContainersTable = "containers"
+	// ContainersInverseTable is the table name for the Container entity.
+	// It exists in this package in order to avoid circular dependency with the "container" package.
+	ContainersInverseTable = "containers"
+	// ContainersColumn is the table column denoting the containers relation/edge.
+	ContainersColumn = "image_containers"
+)
+
+// Columns holds all SQL columns for image fields.
+var Columns = []string{
+	FieldID,
+	FieldImageName,
+	FieldDigest,
+	FieldLanguage,
+	FieldNanoCpus,
+	FieldMemoryBytes,
+	FieldPidsLimit,
+	FieldNetworkMode,
+	FieldPullStatus,
+	FieldPullMessage,
+	FieldPullTime,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ImageNameValidator is a validator for the "image_name" field. It is called by the builders before save.
+	ImageNameValidator func(string) error
+	// DigestValidator is a validator for the "digest" field. It is called by the builders before save.
+	DigestValidator func(string) error
+	// LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	LanguageValidator func(string) error
+	// NanoCpusValidator is a validator for the "nano_cpus" field. It is called by the builders before save.
+	NanoCpusValidator func(int64) error
+	// MemoryBytesValidator is a validator for the "memory_bytes" field. It is called by the builders before save.
+	MemoryBytesValidator func(int64) error
+	// PidsLimitValidator is a validator for the "pids_limit" field. It is called by the builders before save.
+	PidsLimitValidator func(int64) error
+)
+
+// NetworkModeValidator is a validator for the "network_mode" field enum values. It is called by the builders before save.
+func NetworkModeValidator(nm property.NetworkMode) error {
+	switch nm {
+	case "NONE", "INTERNAL", "EGRESS":
+		return nil
+	default:
+		return fmt.Errorf("image: invalid enum value for network_mode field: %q", nm)
+	}
+}
+
+const DefaultPullStatus property.ImagePullStatus = "PENDING"
+
+// PullStatusValidator is a validator for the "pull_status" field enum values. It is called by the builders before save.
+func PullStatusValidator(ps property.ImagePullStatus) error {
+	switch ps {
+	case "PENDING", "PULLING", "PULLED", "FAILED":
+		return nil
+	default:
+		return fmt.Errorf("image: invalid enum value for pull_status field: %q", ps)
+	}
+}
+
+// OrderOption defines the ordering options for the Image queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByImageName orders the results by the image_name field.
+func ByImageName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageName, opts...).ToFunc()
+}
+
+// ByDigest orders the results by the digest field.
+func ByDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigest, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByNanoCpus orders the results by the nano_cpus field.
+func ByNanoCpus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNanoCpus, opts...).ToFunc()
+}
+
+// ByMemoryBytes orders the results by the memory_bytes field.
+func ByMemoryBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryBytes, opts...).ToFunc()
+}
+
+// ByPidsLimit orders the results by the pids_limit field.
+func ByPidsLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPidsLimit, opts...).ToFunc()
+}
+
+// ByNetworkMode orders the results by the network_mode field.
+func ByNetworkMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetworkMode, opts...).ToFunc()
+}
+
+// ByPullStatus orders the results by the pull_status field.
+func ByPullStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPullStatus, opts...).ToFunc()
+}
+
+// ByPullMessage orders the results by the pull_message field.
+func ByPullMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPullMessage, opts...).ToFunc()
+}
+
+// ByPullTime orders the results by the pull_time field.
+func ByPullTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPullTime, opts...).ToFunc()
+}
+
+// ByContainersCount orders the results by containers count.
+func ByContainersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContainersStep(), opts...)
+	}
+}
+
+// ByContainers orders the results by containers terms.
+func ByContainers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContainersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newContainersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContainersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContainersTable, ContainersColumn),
+	)
+}
@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package image
+
+import (
+	"liteide-backend/ent/predicate"
+	"liteide-backend/ent/property"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldID, id))
+}
+
+// ImageName applies equality check predicate on the "image_name" field. It's identical to ImageNameEQ.
+func ImageName(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldImageName, v))
+}
+
+// Digest applies equality check predicate on the "digest" field. It's identical to DigestEQ.
+func Digest(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldDigest, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldEQ(FieldLanguage, vc))
+}
+
+// NanoCpus applies equality check predicate on the "nano_cpus" field. It's identical to NanoCpusEQ.
+func NanoCpus(v int64) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldNanoCpus, v))
+}
+
+// MemoryBytes applies equality check predicate on the "memory_bytes" field. It's identical to MemoryBytesEQ.
+func MemoryBytes(v int64) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldMemoryBytes, v))
+}
+
+// PidsLimit applies equality check predicate on the "pids_limit" field. It's identical to PidsLimitEQ.
+func PidsLimit(v int64) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldPidsLimit, v))
+}
+
+// PullMessage applies equality check predicate on the "pull_message" field. It's identical to PullMessageEQ.
+func PullMessage(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldPullMessage, v))
+}
+
+// PullTime applies equality check predicate on the "pull_time" field. It's identical to PullTimeEQ.
+func PullTime(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldPullTime, v))
+}
+
+// ImageNameEQ applies the EQ predicate on the "image_name" field.
+func ImageNameEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldImageName, v))
+}
+
+// ImageNameNEQ applies the NEQ predicate on the "image_name" field.
+func ImageNameNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldImageName, v))
+}
+
+// ImageNameIn applies the In predicate on the "image_name" field.
+func ImageNameIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldImageName, vs...))
+}
+
+// ImageNameNotIn applies the NotIn predicate on the "image_name" field.
+func ImageNameNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldImageName, vs...))
+}
+
+// ImageNameGT applies the GT predicate on the "image_name" field.
+func ImageNameGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldImageName, v))
+}
+
+// ImageNameGTE applies the GTE predicate on the "image_name" field.
+func ImageNameGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldImageName, v))
+}
+
+// ImageNameLT applies the LT predicate on the "image_name" field.
+func ImageNameLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldImageName, v))
+}
+
+// ImageNameLTE applies the LTE predicate on the "image_name" field.
+func ImageNameLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldImageName, v))
+}
+
+// ImageNameContains applies the Contains predicate on the "image_name" field.
+func ImageNameContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldImageName, v))
+}
+
+// ImageNameHasPrefix applies the HasPrefix predicate on the "image_name" field.
+func ImageNameHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldImageName, v))
+}
+
+// ImageNameHasSuffix applies the HasSuffix predicate on the "image_name" field.
+func ImageNameHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldImageName, v))
+}
+
+// ImageNameEqualFold applies the EqualFold predicate on the "image_name" field.
+func ImageNameEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldImageName, v))
+}
+
+// ImageNameContainsFold applies the ContainsFold predicate on the "image_name" field.
+func ImageNameContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldImageName, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// DigestGT applies the GT predicate on the "digest" field.
+func DigestGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldDigest, v))
+}
+
+// DigestGTE applies the GTE predicate on the "digest" field.
+func DigestGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldDigest, v))
+}
+
+// DigestLT applies the LT predicate on the "digest" field.
+func DigestLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldDigest, v))
+}
+
+// DigestLTE applies the LTE predicate on the "digest" field.
+func DigestLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldDigest, v))
+}
+
+// DigestContains applies the Contains predicate on the "digest" field.
+func DigestContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldDigest, v))
+}
+
+// DigestHasPrefix applies the HasPrefix predicate on the "digest" field.
+func DigestHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldDigest, v))
+}
+
+// DigestHasSuffix applies the HasSuffix predicate on the "digest" field.
+func DigestHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldDigest, v))
+}
+
+// DigestIsNil applies the IsNil predicate on the "digest" field.
+func DigestIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldDigest))
+}
+
+// DigestNotNil applies the NotNil predicate on the "digest" field.
+func DigestNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldDigest))
+}
+
+// DigestEqualFold applies the EqualFold predicate on the "digest" field.
+func DigestEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldDigest, v))
+}
+
+// DigestContainsFold applies the ContainsFold predicate on the "digest" field.
+func DigestContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldDigest, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldEQ(FieldLanguage, vc))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldNEQ(FieldLanguage, vc))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...property.Language) predicate.Image {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Image(sql.FieldIn(FieldLanguage, v...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...property.Language) predicate.Image {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Image(sql.FieldNotIn(FieldLanguage, v...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldGT(FieldLanguage, vc))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldGTE(FieldLanguage, vc))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldLT(FieldLanguage, vc))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldLTE(FieldLanguage, vc))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldContains(FieldLanguage, vc))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldHasPrefix(FieldLanguage, vc))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldHasSuffix(FieldLanguage, vc))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldEqualFold(FieldLanguage, vc))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v property.Language) predicate.Image {
+	vc := string(v)
+	return predicate.Image(sql.FieldContainsFold(FieldLanguage, vc))
+}
+
+// NanoCpusEQ applies the EQ predicate on the "nano_cpus" field.
+func NanoCpusEQ(v int64) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldNanoCpus, v))
+}
+
+// NanoCpusNEQ applies the NEQ predicate on the "nano_cpus" field.
+func NanoCpusNEQ(v int64) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldNanoCpus, v))
+}
+
+// NanoCpusIn applies the In predicate on the "nano_cpus" field.
+func NanoCpusIn(vs ...int64) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldNanoCpus, vs...))
+}
+
+// NanoCpusNotIn applies the NotIn predicate on the "nano_cpus" field.
+func NanoCpusNotIn(vs ...int64) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldNanoCpus, vs...))
+}
+
+// NanoCpusGT applies the GT predicate on the "nano_cpus" field.
+func NanoCpusGT(v int64) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldNanoCpus, v))
+}
+
+// NanoCpusGTE applies the GTE predicate on the "nano_cpus" field.
+func NanoCpusGTE(v int64) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldNanoCpus, v))
+}
+
+// NanoCpusLT applies the LT predicate on the "nano_cpus" field.
+func NanoCpusLT(v int64) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldNanoCpus, v))
+}
+
+// NanoCpusLTE applies the LTE predicate on the "nano_cpus" field.
+func NanoCpusLTE(v int64) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldNanoCpus, v))
+}
+
+// NanoCpusIsNil applies the IsNil predicate on the "nano_cpus" field.
+func NanoCpusIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldNanoCpus))
+}
+
+// NanoCpusNotNil applies the NotNil predicate on the "nano_cpus" field.
+func NanoCpusNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldNanoCpus))
+}
+
+// MemoryBytesEQ applies the EQ predicate on the "memory_bytes" field.
+func MemoryBytesEQ(v int64) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldMemoryBytes, v))
+}
+
+// MemoryBytesNEQ applies the NEQ predicate on the "memory_bytes" field.
+func MemoryBytesNEQ(v int64) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldMemoryBytes, v))
+}
+
+// MemoryBytesIn applies the In predicate on the "memory_bytes" field.
+func MemoryBytesIn(vs ...int64) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldMemoryBytes, vs...))
+}
+
+// MemoryBytesNotIn applies the NotIn predicate on the "memory_bytes" field.
+func MemoryBytesNotIn(vs ...int64) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldMemoryBytes, vs...))
+}
+
+// MemoryBytesGT applies the GT predicate on the "memory_bytes" field.
+func MemoryBytesGT(v int64) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldMemoryBytes, v))
+}
+
+// MemoryBytesGTE applies the GTE predicate on the "memory_bytes" field.
+func MemoryBytesGTE(v int64) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldMemoryBytes, v))
+}
+
+// MemoryBytesLT applies the LT predicate on the "memory_bytes" field.
+func MemoryBytesLT(v int64) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldMemoryBytes, v))
+}
+
+// MemoryBytesLTE applies the LTE predicate on the "memory_bytes" field.
+func MemoryBytesLTE(v int64) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldMemoryBytes, v))
+}
+
+// MemoryBytesIsNil applies the IsNil predicate on the "memory_bytes" field.
+func MemoryBytesIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldMemoryBytes))
+}
+
+// MemoryBytesNotNil applies the NotNil predicate on the "memory_bytes" field.
+func MemoryBytesNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldMemoryBytes))
+}
+
+// PidsLimitEQ applies the EQ predicate on the "pids_limit" field.
+func PidsLimitEQ(v int64) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldPidsLimit, v))
+}
+
+// PidsLimitNEQ applies the NEQ predicate on the "pids_limit" field.
+func PidsLimitNEQ(v int64) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldPidsLimit, v))
+}
+
+// PidsLimitIn applies the In predicate on the "pids_limit" field.
+func PidsLimitIn(vs ...int64) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldPidsLimit, vs...))
+}
+
+// PidsLimitNotIn applies the NotIn predicate on the "pids_limit" field.
+func PidsLimitNotIn(vs ...int64) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldPidsLimit, vs...))
+}
+
+// PidsLimitGT applies the GT predicate on the "pids_limit" field.
+func PidsLimitGT(v int64) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldPidsLimit, v))
+}
+
+// PidsLimitGTE applies the GTE predicate on the "pids_limit" field.
+func PidsLimitGTE(v int64) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldPidsLimit, v))
+}
+
+// PidsLimitLT applies the LT predicate on the "pids_limit" field.
+func PidsLimitLT(v int64) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldPidsLimit, v))
+}
+
+// PidsLimitLTE applies the LTE predicate on the "pids_limit" field.
+func PidsLimitLTE(v int64) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldPidsLimit, v))
+}
+
+// PidsLimitIsNil applies the IsNil predicate on the "pids_limit" field.
+func PidsLimitIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldPidsLimit))
+}
+
+// PidsLimitNotNil applies the NotNil predicate on the "pids_limit" field.
+func PidsLimitNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldPidsLimit))
+}
+
+// NetworkModeEQ applies the EQ predicate on the "network_mode" field.
+func NetworkModeEQ(v property.NetworkMode) predicate.Image {
+	vc := v
+	return predicate.Image(sql.FieldEQ(FieldNetworkMode, vc))
+}
+
+// NetworkModeNEQ applies the NEQ predicate on the "network_mode" field.
+func NetworkModeNEQ(v property.NetworkMode) predicate.Image {
+	vc := v
+	return predicate.Image(sql.FieldNEQ(FieldNetworkMode, vc))
+}
+
+// NetworkModeIn applies the In predicate on the "network_mode" field.
+func NetworkModeIn(vs ...property.NetworkMode) predicate.Image {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Image(sql.FieldIn(FieldNetworkMode, v...))
+}
+
+// NetworkModeNotIn applies the NotIn predicate on the "network_mode" field.
+func NetworkModeNotIn(vs ...property.NetworkMode) predicate.Image {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Image(sql.FieldNotIn(FieldNetworkMode, v...))
+}
+
+// NetworkModeIsNil applies the IsNil predicate on the "network_mode" field.
+func NetworkModeIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldNetworkMode))
+}
+
+// NetworkModeNotNil applies the NotNil predicate on the "network_mode" field.
+func NetworkModeNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldNetworkMode))
+}
+
+// PullStatusEQ applies the EQ predicate on the "pull_status" field.
+func PullStatusEQ(v property.ImagePullStatus) predicate.Image {
+	vc := v
+	return predicate.Image(sql.FieldEQ(FieldPullStatus, vc))
+}
+
+// PullStatusNEQ applies the NEQ predicate on the "pull_status" field.
+func PullStatusNEQ(v property.ImagePullStatus) predicate.Image {
+	vc := v
+	return predicate.Image(sql.FieldNEQ(FieldPullStatus, vc))
+}
+
+// PullStatusIn applies the In predicate on the "pull_status" field.
+func PullStatusIn(vs ...property.ImagePullStatus) predicate.Image {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Image(sql.FieldIn(FieldPullStatus, v...))
+}
+
+// PullStatusNotIn applies the NotIn predicate on the "pull_status" field.
+func PullStatusNotIn(vs ...property.ImagePullStatus) predicate.Image {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Image(sql.FieldNotIn(FieldPullStatus, v...))
+}
+
+// PullMessageEQ applies the EQ predicate on the "pull_message" field.
+func PullMessageEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldPullMessage, v))
+}
+
+// PullMessageNEQ applies the NEQ predicate on the "pull_message" field.
+func PullMessageNEQ(v string) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldPullMessage, v))
+}
+
+// PullMessageIn applies the In predicate on the "pull_message" field.
+func PullMessageIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldPullMessage, vs...))
+}
+
+// PullMessageNotIn applies the NotIn predicate on the "pull_message" field.
+func PullMessageNotIn(vs ...string) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldPullMessage, vs...))
+}
+
+// PullMessageGT applies the GT predicate on the "pull_message" field.
+func PullMessageGT(v string) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldPullMessage, v))
+}
+
+// PullMessageGTE applies the GTE predicate on the "pull_message" field.
+func PullMessageGTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldPullMessage, v))
+}
+
+// PullMessageLT applies the LT predicate on the "pull_message" field.
+func PullMessageLT(v string) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldPullMessage, v))
+}
+
+// PullMessageLTE applies the LTE predicate on the "pull_message" field.
+func PullMessageLTE(v string) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldPullMessage, v))
+}
+
+// PullMessageContains applies the Contains predicate on the "pull_message" field.
+func PullMessageContains(v string) predicate.Image {
+	return predicate.Image(sql.FieldContains(FieldPullMessage, v))
+}
+
+// PullMessageHasPrefix applies the HasPrefix predicate on the "pull_message" field.
+func PullMessageHasPrefix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasPrefix(FieldPullMessage, v))
+}
+
+// PullMessageHasSuffix applies the HasSuffix predicate on the "pull_message" field.
+func PullMessageHasSuffix(v string) predicate.Image {
+	return predicate.Image(sql.FieldHasSuffix(FieldPullMessage, v))
+}
+
+// PullMessageIsNil applies the IsNil predicate on the "pull_message" field.
+func PullMessageIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldPullMessage))
+}
+
+// PullMessageNotNil applies the NotNil predicate on the "pull_message" field.
+func PullMessageNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldPullMessage))
+}
+
+// PullMessageEqualFold applies the EqualFold predicate on the "pull_message" field.
+func PullMessageEqualFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldEqualFold(FieldPullMessage, v))
+}
+
+// PullMessageContainsFold applies the ContainsFold predicate on the "pull_message" field.
+func PullMessageContainsFold(v string) predicate.Image {
+	return predicate.Image(sql.FieldContainsFold(FieldPullMessage, v))
+}
+
+// PullTimeEQ applies the EQ predicate on the "pull_time" field.
+func PullTimeEQ(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldEQ(FieldPullTime, v))
+}
+
+// PullTimeNEQ applies the NEQ predicate on the "pull_time" field.
+func PullTimeNEQ(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldNEQ(FieldPullTime, v))
+}
+
+// PullTimeIn applies the In predicate on the "pull_time" field.
+func PullTimeIn(vs ...time.Time) predicate.Image {
+	return predicate.Image(sql.FieldIn(FieldPullTime, vs...))
+}
+
+// PullTimeNotIn applies the NotIn predicate on the "pull_time" field.
+func PullTimeNotIn(vs ...time.Time) predicate.Image {
+	return predicate.Image(sql.FieldNotIn(FieldPullTime, vs...))
+}
+
+// PullTimeGT applies the GT predicate on the "pull_time" field.
+func PullTimeGT(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldGT(FieldPullTime, v))
+}
+
+// PullTimeGTE applies the GTE predicate on the "pull_time" field.
+func PullTimeGTE(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldGTE(FieldPullTime, v))
+}
+
+// PullTimeLT applies the LT predicate on the "pull_time" field.
+func PullTimeLT(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldLT(FieldPullTime, v))
+}
+
+// PullTimeLTE applies the LTE predicate on the "pull_time" field.
+func PullTimeLTE(v time.Time) predicate.Image {
+	return predicate.Image(sql.FieldLTE(FieldPullTime, v))
+}
+
+// PullTimeIsNil applies the IsNil predicate on the "pull_time" field.
+func PullTimeIsNil() predicate.Image {
+	return predicate.Image(sql.FieldIsNull(FieldPullTime))
+}
+
+// PullTimeNotNil applies the NotNil predicate on the "pull_time" field.
+func PullTimeNotNil() predicate.Image {
+	return predicate.Image(sql.FieldNotNull(FieldPullTime))
+}
+
+// HasContainers applies the HasEdge predicate on the "containers" edge.
+func HasContainers() predicate.Image {
+	return predicate.Image(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContainersTable, ContainersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContainersWith applies the HasEdge predicate on the "containers" edge with a given conditions (other predicates).
+func HasContainersWith(preds ...predicate.Container) predicate.Image {
+	return predicate.Image(func(s *sql.Selector) {
+		step := newContainersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Image) predicate.Image {
+	return predicate.Image(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Image) predicate.Image {
+	return predicate.Image(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Image) predicate.Image {
+	return predicate.Image(sql.NotPredicates(p))
+}
@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/property"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImageCreate is the builder for creating a Image entity.
+type ImageCreate struct {
+	config
+	mutation *ImageMutation
+	hooks    []Hook
+}
+
+// SetImageName sets the "image_name" field.
+func (_c *ImageCreate) SetImageName(v string) *ImageCreate {
+	_c.mutation.SetImageName(v)
+	return _c
+}
+
+// SetDigest sets the "digest" field.
+func (_c *ImageCreate) SetDigest(v string) *ImageCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableDigest(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetDigest(*v)
+	}
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *ImageCreate) SetLanguage(v property.Language) *ImageCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNanoCpus sets the "nano_cpus" field.
+func (_c *ImageCreate) SetNanoCpus(v int64) *ImageCreate {
+	_c.mutation.SetNanoCpus(v)
+	return _c
+}
+
+// SetNillableNanoCpus sets the "nano_cpus" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableNanoCpus(v *int64) *ImageCreate {
+	if v != nil {
+		_c.SetNanoCpus(*v)
+	}
+	return _c
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (_c *ImageCreate) SetMemoryBytes(v int64) *ImageCreate {
+	_c.mutation.SetMemoryBytes(v)
+	return _c
+}
+
+// SetNillableMemoryBytes sets the "memory_bytes" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableMemoryBytes(v *int64) *ImageCreate {
+	if v != nil {
+		_c.SetMemoryBytes(*v)
+	}
+	return _c
+}
+
+// SetPidsLimit sets the "pids_limit" field.
+func (_c *ImageCreate) SetPidsLimit(v int64) *ImageCreate {
+	_c.mutation.SetPidsLimit(v)
+	return _c
+}
+
+// SetNillablePidsLimit sets the "pids_limit" field if the given value is not nil.
+func (_c *ImageCreate) SetNillablePidsLimit(v *int64) *ImageCreate {
+	if v != nil {
+		_c.SetPidsLimit(*v)
+	}
+	return _c
+}
+
+// SetNetworkMode sets the "network_mode" field.
+func (_c *ImageCreate) SetNetworkMode(v property.NetworkMode) *ImageCreate {
+	_c.mutation.SetNetworkMode(v)
+	return _c
+}
+
+// SetNillableNetworkMode sets the "network_mode" field if the given value is not nil.
+func (_c *ImageCreate) SetNillableNetworkMode(v *property.NetworkMode) *ImageCreate {
+	if v != nil {
+		_c.SetNetworkMode(*v)
+	}
+	return _c
+}
+
+// SetPullStatus sets the "pull_status" field.
+func (_c *ImageCreate) SetPullStatus(v property.ImagePullStatus) *ImageCreate {
+	_c.mutation.SetPullStatus(v)
+	return _c
+}
+
+// SetNillablePullStatus sets the "pull_status" field if the given value is not nil.
+func (_c *ImageCreate) SetNillablePullStatus(v *property.ImagePullStatus) *ImageCreate {
+	if v != nil {
+		_c.SetPullStatus(*v)
+	}
+	return _c
+}
+
+// SetPullMessage sets the "pull_message" field.
+func (_c *ImageCreate) SetPullMessage(v string) *ImageCreate {
+	_c.mutation.SetPullMessage(v)
+	return _c
+}
+
+// SetNillablePullMessage sets the "pull_message" field if the given value is not nil.
+func (_c *ImageCreate) SetNillablePullMessage(v *string) *ImageCreate {
+	if v != nil {
+		_c.SetPullMessage(*v)
+	}
+	return _c
+}
+
+// SetPullTime sets the "pull_time" field.
+func (_c *ImageCreate) SetPullTime(v time.Time) *ImageCreate {
+	_c.mutation.SetPullTime(v)
+	return _c
+}
+
+// SetNillablePullTime sets the "pull_time" field if the given value is not nil.
+func (_c *ImageCreate) SetNillablePullTime(v *time.Time) *ImageCreate {
+	if v != nil {
+		_c.SetPullTime(*v)
+	}
+	return _c
+}
+
+// AddContainerIDs adds the "containers" edge to the Container entity by IDs.
+func (_c *ImageCreate) AddContainerIDs(ids ...int) *ImageCreate {
+	_c.mutation.AddContainerIDs(ids...)
+	return _c
+}
+
+// AddContainers adds the "containers" edges to the Container entity.
+func (_c *ImageCreate) AddContainers(v ...*Container) *ImageCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContainerIDs(ids...)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (_c *ImageCreate) Mutation() *ImageMutation {
+	return _c.mutation
+}
+
+// Save creates the Image in the database.
+func (_c *ImageCreate) Save(ctx context.Context) (*Image, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImageCreate) SaveX(ctx context.Context) *Image {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImageCreate) defaults() {
+	if _, ok := _c.mutation.PullStatus(); !ok {
+		v := image.DefaultPullStatus
+		_c.mutation.SetPullStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImageCreate) check() error {
+	if _, ok := _c.mutation.ImageName(); !ok {
+		return &ValidationError{Name: "image_name", err: errors.New(`ent: missing required field "Image.image_name"`)}
+	}
+	if v, ok := _c.mutation.ImageName(); ok {
+		if err := image.ImageNameValidator(v); err != nil {
+			return &ValidationError{Name: "image_name", err: fmt.Errorf(`ent: validator failed for field "Image.image_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Digest(); ok {
+		if err := image.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "Image.digest": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`ent: missing required field "Image.language"`)}
+	}
+	if v, ok := _c.mutation.Language(); ok {
+		if err := image.LanguageValidator(string(v)); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Image.language": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NanoCpus(); ok {
+		if err := image.NanoCpusValidator(v); err != nil {
+			return &ValidationError{Name: "nano_cpus", err: fmt.Errorf(`ent: validator failed for field "Image.nano_cpus": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MemoryBytes(); ok {
+		if err := image.MemoryBytesValidator(v); err != nil {
+			return &ValidationError{Name: "memory_bytes", err: fmt.Errorf(`ent: validator failed for field "Image.memory_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PidsLimit(); ok {
+		if err := image.PidsLimitValidator(v); err != nil {
+			return &ValidationError{Name: "pids_limit", err: fmt.Errorf(`ent: validator failed for field "Image.pids_limit": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NetworkMode(); ok {
+		if err := image.NetworkModeValidator(v); err != nil {
+			return &ValidationError{Name: "network_mode", err: fmt.Errorf(`ent: validator failed for field "Image.network_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PullStatus(); !ok {
+		return &ValidationError{Name: "pull_status", err: errors.New(`ent: missing required field "Image.pull_status"`)}
+	}
+	if v, ok := _c.mutation.PullStatus(); ok {
+		if err := image.PullStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pull_status", err: fmt.Errorf(`ent: validator failed for field "Image.pull_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ImageCreate) sqlSave(ctx context.Context) (*Image, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImageCreate) createSpec() (*Image, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Image{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(image.Table, sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ImageName(); ok {
+		_spec.SetField(image.FieldImageName, field.TypeString, value)
+		_node.ImageName = value
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(image.FieldDigest, field.TypeString, value)
+		_node.Digest = &value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(image.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.NanoCpus(); ok {
+		_spec.SetField(image.FieldNanoCpus, field.TypeInt64, value)
+		_node.NanoCpus = &value
+	}
+	if value, ok := _c.mutation.MemoryBytes(); ok {
+		_spec.SetField(image.FieldMemoryBytes, field.TypeInt64, value)
+		_node.MemoryBytes = &value
+	}
+	if value, ok := _c.mutation.PidsLimit(); ok {
+		_spec.SetField(image.FieldPidsLimit, field.TypeInt64, value)
+		_node.PidsLimit = &value
+	}
+	if value, ok := _c.mutation.NetworkMode(); ok {
+		_spec.SetField(image.FieldNetworkMode, field.TypeEnum, value)
+		_node.NetworkMode = &value
+	}
+	if value, ok := _c.mutation.PullStatus(); ok {
+		_spec.SetField(image.FieldPullStatus, field.TypeEnum, value)
+		_node.PullStatus = value
+	}
+	if value, ok := _c.mutation.PullMessage(); ok {
+		_spec.SetField(image.FieldPullMessage, field.TypeString, value)
+		_node.PullMessage = &value
+	}
+	if value, ok := _c.mutation.PullTime(); ok {
+		_spec.SetField(image.FieldPullTime, field.TypeTime, value)
+		_node.PullTime = &value
+	}
+	if nodes := _c.mutation.ContainersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   image.ContainersTable,
+			Columns: []string{image.ContainersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImageCreateBulk is the builder for creating many Image entities in bulk.
+type ImageCreateBulk struct {
+	config
+	err      error
+	builders []*ImageCreate
+}
+
+// Save creates the Image entities in the database.
+func (_c *ImageCreateBulk) Save(ctx context.Context) ([]*Image, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Image, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImageCreateBulk) SaveX(ctx context.Context) []*Image {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
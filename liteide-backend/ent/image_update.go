@@ -0,0 +1,962 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/predicate"
+	"liteide-backend/ent/property"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImageUpdate is the builder for updating Image entities.
+type ImageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImageMutation
+}
+
+// Where appends a list predicates to the ImageUpdate builder.
+func (_u *ImageUpdate) Where(ps ...predicate.Image) *ImageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetImageName sets the "image_name" field.
+func (_u *ImageUpdate) SetImageName(v string) *ImageUpdate {
+	_u.mutation.SetImageName(v)
+	return _u
+}
+
+// SetNillableImageName sets the "image_name" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableImageName(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetImageName(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *ImageUpdate) SetDigest(v string) *ImageUpdate {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableDigest(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *ImageUpdate) ClearDigest() *ImageUpdate {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *ImageUpdate) SetLanguage(v property.Language) *ImageUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableLanguage(v *property.Language) *ImageUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetNanoCpus sets the "nano_cpus" field.
+func (_u *ImageUpdate) SetNanoCpus(v int64) *ImageUpdate {
+	_u.mutation.ResetNanoCpus()
+	_u.mutation.SetNanoCpus(v)
+	return _u
+}
+
+// SetNillableNanoCpus sets the "nano_cpus" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableNanoCpus(v *int64) *ImageUpdate {
+	if v != nil {
+		_u.SetNanoCpus(*v)
+	}
+	return _u
+}
+
+// AddNanoCpus adds value to the "nano_cpus" field.
+func (_u *ImageUpdate) AddNanoCpus(v int64) *ImageUpdate {
+	_u.mutation.AddNanoCpus(v)
+	return _u
+}
+
+// ClearNanoCpus clears the value of the "nano_cpus" field.
+func (_u *ImageUpdate) ClearNanoCpus() *ImageUpdate {
+	_u.mutation.ClearNanoCpus()
+	return _u
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (_u *ImageUpdate) SetMemoryBytes(v int64) *ImageUpdate {
+	_u.mutation.ResetMemoryBytes()
+	_u.mutation.SetMemoryBytes(v)
+	return _u
+}
+
+// SetNillableMemoryBytes sets the "memory_bytes" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableMemoryBytes(v *int64) *ImageUpdate {
+	if v != nil {
+		_u.SetMemoryBytes(*v)
+	}
+	return _u
+}
+
+// AddMemoryBytes adds value to the "memory_bytes" field.
+func (_u *ImageUpdate) AddMemoryBytes(v int64) *ImageUpdate {
+	_u.mutation.AddMemoryBytes(v)
+	return _u
+}
+
+// ClearMemoryBytes clears the value of the "memory_bytes" field.
+func (_u *ImageUpdate) ClearMemoryBytes() *ImageUpdate {
+	_u.mutation.ClearMemoryBytes()
+	return _u
+}
+
+// SetPidsLimit sets the "pids_limit" field.
+func (_u *ImageUpdate) SetPidsLimit(v int64) *ImageUpdate {
+	_u.mutation.ResetPidsLimit()
+	_u.mutation.SetPidsLimit(v)
+	return _u
+}
+
+// SetNillablePidsLimit sets the "pids_limit" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillablePidsLimit(v *int64) *ImageUpdate {
+	if v != nil {
+		_u.SetPidsLimit(*v)
+	}
+	return _u
+}
+
+// AddPidsLimit adds value to the "pids_limit" field.
+func (_u *ImageUpdate) AddPidsLimit(v int64) *ImageUpdate {
+	_u.mutation.AddPidsLimit(v)
+	return _u
+}
+
+// ClearPidsLimit clears the value of the "pids_limit" field.
+func (_u *ImageUpdate) ClearPidsLimit() *ImageUpdate {
+	_u.mutation.ClearPidsLimit()
+	return _u
+}
+
+// SetNetworkMode sets the "network_mode" field.
+func (_u *ImageUpdate) SetNetworkMode(v property.NetworkMode) *ImageUpdate {
+	_u.mutation.SetNetworkMode(v)
+	return _u
+}
+
+// SetNillableNetworkMode sets the "network_mode" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillableNetworkMode(v *property.NetworkMode) *ImageUpdate {
+	if v != nil {
+		_u.SetNetworkMode(*v)
+	}
+	return _u
+}
+
+// ClearNetworkMode clears the value of the "network_mode" field.
+func (_u *ImageUpdate) ClearNetworkMode() *ImageUpdate {
+	_u.mutation.ClearNetworkMode()
+	return _u
+}
+
+// SetPullStatus sets the "pull_status" field.
+func (_u *ImageUpdate) SetPullStatus(v property.ImagePullStatus) *ImageUpdate {
+	_u.mutation.SetPullStatus(v)
+	return _u
+}
+
+// SetNillablePullStatus sets the "pull_status" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillablePullStatus(v *property.ImagePullStatus) *ImageUpdate {
+	if v != nil {
+		_u.SetPullStatus(*v)
+	}
+	return _u
+}
+
+// SetPullMessage sets the "pull_message" field.
+func (_u *ImageUpdate) SetPullMessage(v string) *ImageUpdate {
+	_u.mutation.SetPullMessage(v)
+	return _u
+}
+
+// SetNillablePullMessage sets the "pull_message" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillablePullMessage(v *string) *ImageUpdate {
+	if v != nil {
+		_u.SetPullMessage(*v)
+	}
+	return _u
+}
+
+// ClearPullMessage clears the value of the "pull_message" field.
+func (_u *ImageUpdate) ClearPullMessage() *ImageUpdate {
+	_u.mutation.ClearPullMessage()
+	return _u
+}
+
+// SetPullTime sets the "pull_time" field.
+func (_u *ImageUpdate) SetPullTime(v time.Time) *ImageUpdate {
+	_u.mutation.SetPullTime(v)
+	return _u
+}
+
+// SetNillablePullTime sets the "pull_time" field if the given value is not nil.
+func (_u *ImageUpdate) SetNillablePullTime(v *time.Time) *ImageUpdate {
+	if v != nil {
+		_u.SetPullTime(*v)
+	}
+	return _u
+}
+
+// ClearPullTime clears the value of the "pull_time" field.
+func (_u *ImageUpdate) ClearPullTime() *ImageUpdate {
+	_u.mutation.ClearPullTime()
+	return _u
+}
+
+// AddContainerIDs adds the "containers" edge to the Container entity by IDs.
+func (_u *ImageUpdate) AddContainerIDs(ids ...int) *ImageUpdate {
+	_u.mutation.AddContainerIDs(ids...)
+	return _u
+}
+
+// AddContainers adds the "containers" edges to the Container entity.
+func (_u *ImageUpdate) AddContainers(v ...*Container) *ImageUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContainerIDs(ids...)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (_u *ImageUpdate) Mutation() *ImageMutation {
+	return _u.mutation
+}
+
+// ClearContainers clears all "containers" edges to the Container entity.
+func (_u *ImageUpdate) ClearContainers() *ImageUpdate {
+	_u.mutation.ClearContainers()
+	return _u
+}
+
+// RemoveContainerIDs removes the "containers" edge to Container entities by IDs.
+func (_u *ImageUpdate) RemoveContainerIDs(ids ...int) *ImageUpdate {
+	_u.mutation.RemoveContainerIDs(ids...)
+	return _u
+}
+
+// RemoveContainers removes "containers" edges to Container entities.
+func (_u *ImageUpdate) RemoveContainers(v ...*Container) *ImageUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContainerIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImageUpdate) check() error {
+	if v, ok := _u.mutation.ImageName(); ok {
+		if err := image.ImageNameValidator(v); err != nil {
+			return &ValidationError{Name: "image_name", err: fmt.Errorf(`ent: validator failed for field "Image.image_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Digest(); ok {
+		if err := image.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "Image.digest": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := image.LanguageValidator(string(v)); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Image.language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NanoCpus(); ok {
+		if err := image.NanoCpusValidator(v); err != nil {
+			return &ValidationError{Name: "nano_cpus", err: fmt.Errorf(`ent: validator failed for field "Image.nano_cpus": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MemoryBytes(); ok {
+		if err := image.MemoryBytesValidator(v); err != nil {
+			return &ValidationError{Name: "memory_bytes", err: fmt.Errorf(`ent: validator failed for field "Image.memory_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PidsLimit(); ok {
+		if err := image.PidsLimitValidator(v); err != nil {
+			return &ValidationError{Name: "pids_limit", err: fmt.Errorf(`ent: validator failed for field "Image.pids_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NetworkMode(); ok {
+		if err := image.NetworkModeValidator(v); err != nil {
+			return &ValidationError{Name: "network_mode", err: fmt.Errorf(`ent: validator failed for field "Image.network_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PullStatus(); ok {
+		if err := image.PullStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pull_status", err: fmt.Errorf(`ent: validator failed for field "Image.pull_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(image.Table, image.Columns, sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ImageName(); ok {
+		_spec.SetField(image.FieldImageName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(image.FieldDigest, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(image.FieldDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(image.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NanoCpus(); ok {
+		_spec.SetField(image.FieldNanoCpus, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedNanoCpus(); ok {
+		_spec.AddField(image.FieldNanoCpus, field.TypeInt64, value)
+	}
+	if _u.mutation.NanoCpusCleared() {
+		_spec.ClearField(image.FieldNanoCpus, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MemoryBytes(); ok {
+		_spec.SetField(image.FieldMemoryBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMemoryBytes(); ok {
+		_spec.AddField(image.FieldMemoryBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MemoryBytesCleared() {
+		_spec.ClearField(image.FieldMemoryBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.PidsLimit(); ok {
+		_spec.SetField(image.FieldPidsLimit, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPidsLimit(); ok {
+		_spec.AddField(image.FieldPidsLimit, field.TypeInt64, value)
+	}
+	if _u.mutation.PidsLimitCleared() {
+		_spec.ClearField(image.FieldPidsLimit, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.NetworkMode(); ok {
+		_spec.SetField(image.FieldNetworkMode, field.TypeEnum, value)
+	}
+	if _u.mutation.NetworkModeCleared() {
+		_spec.ClearField(image.FieldNetworkMode, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.PullStatus(); ok {
+		_spec.SetField(image.FieldPullStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PullMessage(); ok {
+		_spec.SetField(image.FieldPullMessage, field.TypeString, value)
+	}
+	if _u.mutation.PullMessageCleared() {
+		_spec.ClearField(image.FieldPullMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.PullTime(); ok {
+		_spec.SetField(image.FieldPullTime, field.TypeTime, value)
+	}
+	if _u.mutation.PullTimeCleared() {
+		_spec.ClearField(image.FieldPullTime, field.TypeTime)
+	}
+	if _u.mutation.ContainersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   image.ContainersTable,
+			Columns: []string{image.ContainersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContainersIDs(); len(nodes) > 0 && !_u.mutation.ContainersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   image.ContainersTable,
+			Columns: []string{image.ContainersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContainersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   image.ContainersTable,
+			Columns: []string{image.ContainersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{image.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImageUpdateOne is the builder for updating a single Image entity.
+type ImageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImageMutation
+}
+
+// SetImageName sets the "image_name" field.
+func (_u *ImageUpdateOne) SetImageName(v string) *ImageUpdateOne {
+	_u.mutation.SetImageName(v)
+	return _u
+}
+
+// SetNillableImageName sets the "image_name" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableImageName(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetImageName(*v)
+	}
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *ImageUpdateOne) SetDigest(v string) *ImageUpdateOne {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableDigest(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetDigest(*v)
+	}
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *ImageUpdateOne) ClearDigest() *ImageUpdateOne {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *ImageUpdateOne) SetLanguage(v property.Language) *ImageUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableLanguage(v *property.Language) *ImageUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetNanoCpus sets the "nano_cpus" field.
+func (_u *ImageUpdateOne) SetNanoCpus(v int64) *ImageUpdateOne {
+	_u.mutation.ResetNanoCpus()
+	_u.mutation.SetNanoCpus(v)
+	return _u
+}
+
+// SetNillableNanoCpus sets the "nano_cpus" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableNanoCpus(v *int64) *ImageUpdateOne {
+	if v != nil {
+		_u.SetNanoCpus(*v)
+	}
+	return _u
+}
+
+// AddNanoCpus adds value to the "nano_cpus" field.
+func (_u *ImageUpdateOne) AddNanoCpus(v int64) *ImageUpdateOne {
+	_u.mutation.AddNanoCpus(v)
+	return _u
+}
+
+// ClearNanoCpus clears the value of the "nano_cpus" field.
+func (_u *ImageUpdateOne) ClearNanoCpus() *ImageUpdateOne {
+	_u.mutation.ClearNanoCpus()
+	return _u
+}
+
+// SetMemoryBytes sets the "memory_bytes" field.
+func (_u *ImageUpdateOne) SetMemoryBytes(v int64) *ImageUpdateOne {
+	_u.mutation.ResetMemoryBytes()
+	_u.mutation.SetMemoryBytes(v)
+	return _u
+}
+
+// SetNillableMemoryBytes sets the "memory_bytes" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableMemoryBytes(v *int64) *ImageUpdateOne {
+	if v != nil {
+		_u.SetMemoryBytes(*v)
+	}
+	return _u
+}
+
+// AddMemoryBytes adds value to the "memory_bytes" field.
+func (_u *ImageUpdateOne) AddMemoryBytes(v int64) *ImageUpdateOne {
+	_u.mutation.AddMemoryBytes(v)
+	return _u
+}
+
+// ClearMemoryBytes clears the value of the "memory_bytes" field.
+func (_u *ImageUpdateOne) ClearMemoryBytes() *ImageUpdateOne {
+	_u.mutation.ClearMemoryBytes()
+	return _u
+}
+
+// SetPidsLimit sets the "pids_limit" field.
+func (_u *ImageUpdateOne) SetPidsLimit(v int64) *ImageUpdateOne {
+	_u.mutation.ResetPidsLimit()
+	_u.mutation.SetPidsLimit(v)
+	return _u
+}
+
+// SetNillablePidsLimit sets the "pids_limit" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillablePidsLimit(v *int64) *ImageUpdateOne {
+	if v != nil {
+		_u.SetPidsLimit(*v)
+	}
+	return _u
+}
+
+// AddPidsLimit adds value to the "pids_limit" field.
+func (_u *ImageUpdateOne) AddPidsLimit(v int64) *ImageUpdateOne {
+	_u.mutation.AddPidsLimit(v)
+	return _u
+}
+
+// ClearPidsLimit clears the value of the "pids_limit" field.
+func (_u *ImageUpdateOne) ClearPidsLimit() *ImageUpdateOne {
+	_u.mutation.ClearPidsLimit()
+	return _u
+}
+
+// SetNetworkMode sets the "network_mode" field.
+func (_u *ImageUpdateOne) SetNetworkMode(v property.NetworkMode) *ImageUpdateOne {
+	_u.mutation.SetNetworkMode(v)
+	return _u
+}
+
+// SetNillableNetworkMode sets the "network_mode" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillableNetworkMode(v *property.NetworkMode) *ImageUpdateOne {
+	if v != nil {
+		_u.SetNetworkMode(*v)
+	}
+	return _u
+}
+
+// ClearNetworkMode clears the value of the "network_mode" field.
+func (_u *ImageUpdateOne) ClearNetworkMode() *ImageUpdateOne {
+	_u.mutation.ClearNetworkMode()
+	return _u
+}
+
+// SetPullStatus sets the "pull_status" field.
+func (_u *ImageUpdateOne) SetPullStatus(v property.ImagePullStatus) *ImageUpdateOne {
+	_u.mutation.SetPullStatus(v)
+	return _u
+}
+
+// SetNillablePullStatus sets the "pull_status" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillablePullStatus(v *property.ImagePullStatus) *ImageUpdateOne {
+	if v != nil {
+		_u.SetPullStatus(*v)
+	}
+	return _u
+}
+
+// SetPullMessage sets the "pull_message" field.
+func (_u *ImageUpdateOne) SetPullMessage(v string) *ImageUpdateOne {
+	_u.mutation.SetPullMessage(v)
+	return _u
+}
+
+// SetNillablePullMessage sets the "pull_message" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillablePullMessage(v *string) *ImageUpdateOne {
+	if v != nil {
+		_u.SetPullMessage(*v)
+	}
+	return _u
+}
+
+// ClearPullMessage clears the value of the "pull_message" field.
+func (_u *ImageUpdateOne) ClearPullMessage() *ImageUpdateOne {
+	_u.mutation.ClearPullMessage()
+	return _u
+}
+
+// SetPullTime sets the "pull_time" field.
+func (_u *ImageUpdateOne) SetPullTime(v time.Time) *ImageUpdateOne {
+	_u.mutation.SetPullTime(v)
+	return _u
+}
+
+// SetNillablePullTime sets the "pull_time" field if the given value is not nil.
+func (_u *ImageUpdateOne) SetNillablePullTime(v *time.Time) *ImageUpdateOne {
+	if v != nil {
+		_u.SetPullTime(*v)
+	}
+	return _u
+}
+
+// ClearPullTime clears the value of the "pull_time" field.
+func (_u *ImageUpdateOne) ClearPullTime() *ImageUpdateOne {
+	_u.mutation.ClearPullTime()
+	return _u
+}
+
+// AddContainerIDs adds the "containers" edge to the Container entity by IDs.
+func (_u *ImageUpdateOne) AddContainerIDs(ids ...int) *ImageUpdateOne {
+	_u.mutation.AddContainerIDs(ids...)
+	return _u
+}
+
+// AddContainers adds the "containers" edges to the Container entity.
+func (_u *ImageUpdateOne) AddContainers(v ...*Container) *ImageUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContainerIDs(ids...)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (_u *ImageUpdateOne) Mutation() *ImageMutation {
+	return _u.mutation
+}
+
+// ClearContainers clears all "containers" edges to the Container entity.
+func (_u *ImageUpdateOne) ClearContainers() *ImageUpdateOne {
+	_u.mutation.ClearContainers()
+	return _u
+}
+
+// RemoveContainerIDs removes the "containers" edge to Container entities by IDs.
+func (_u *ImageUpdateOne) RemoveContainerIDs(ids ...int) *ImageUpdateOne {
+	_u.mutation.RemoveContainerIDs(ids...)
+	return _u
+}
+
+// RemoveContainers removes "containers" edges to Container entities.
+func (_u *ImageUpdateOne) RemoveContainers(v ...*Container) *ImageUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContainerIDs(ids...)
+}
+
+// Where appends a list predicates to the ImageUpdate builder.
+func (_u *ImageUpdateOne) Where(ps ...predicate.Image) *ImageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImageUpdateOne) Select(field string, fields ...string) *ImageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Image entity.
+func (_u *ImageUpdateOne) Save(ctx context.Context) (*Image, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImageUpdateOne) SaveX(ctx context.Context) *Image {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImageUpdateOne) check() error {
+	if v, ok := _u.mutation.ImageName(); ok {
+		if err := image.ImageNameValidator(v); err != nil {
+			return &ValidationError{Name: "image_name", err: fmt.Errorf(`ent: validator failed for field "Image.image_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Digest(); ok {
+		if err := image.DigestValidator(v); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "Image.digest": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := image.LanguageValidator(string(v)); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Image.language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NanoCpus(); ok {
+		if err := image.NanoCpusValidator(v); err != nil {
+			return &ValidationError{Name: "nano_cpus", err: fmt.Errorf(`ent: validator failed for field "Image.nano_cpus": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MemoryBytes(); ok {
+		if err := image.MemoryBytesValidator(v); err != nil {
+			return &ValidationError{Name: "memory_bytes", err: fmt.Errorf(`ent: validator failed for field "Image.memory_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PidsLimit(); ok {
+		if err := image.PidsLimitValidator(v); err != nil {
+			return &ValidationError{Name: "pids_limit", err: fmt.Errorf(`ent: validator failed for field "Image.pids_limit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NetworkMode(); ok {
+		if err := image.NetworkModeValidator(v); err != nil {
+			return &ValidationError{Name: "network_mode", err: fmt.Errorf(`ent: validator failed for field "Image.network_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PullStatus(); ok {
+		if err := image.PullStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pull_status", err: fmt.Errorf(`ent: validator failed for field "Image.pull_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImageUpdateOne) sqlSave(ctx context.Context) (_node *Image, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(image.Table, image.Columns, sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Image.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, image.FieldID)
+		for _, f := range fields {
+			if !image.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != image.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ImageName(); ok {
+		_spec.SetField(image.FieldImageName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(image.FieldDigest, field.TypeString, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(image.FieldDigest, field.TypeString)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(image.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NanoCpus(); ok {
+		_spec.SetField(image.FieldNanoCpus, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedNanoCpus(); ok {
+		_spec.AddField(image.FieldNanoCpus, field.TypeInt64, value)
+	}
+	if _u.mutation.NanoCpusCleared() {
+		_spec.ClearField(image.FieldNanoCpus, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MemoryBytes(); ok {
+		_spec.SetField(image.FieldMemoryBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMemoryBytes(); ok {
+		_spec.AddField(image.FieldMemoryBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MemoryBytesCleared() {
+		_spec.ClearField(image.FieldMemoryBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.PidsLimit(); ok {
+		_spec.SetField(image.FieldPidsLimit, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPidsLimit(); ok {
+		_spec.AddField(image.FieldPidsLimit, field.TypeInt64, value)
+	}
+	if _u.mutation.PidsLimitCleared() {
+		_spec.ClearField(image.FieldPidsLimit, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.NetworkMode(); ok {
+		_spec.SetField(image.FieldNetworkMode, field.TypeEnum, value)
+	}
+	if _u.mutation.NetworkModeCleared() {
+		_spec.ClearField(image.FieldNetworkMode, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.PullStatus(); ok {
+		_spec.SetField(image.FieldPullStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PullMessage(); ok {
+		_spec.SetField(image.FieldPullMessage, field.TypeString, value)
+	}
+	if _u.mutation.PullMessageCleared() {
+		_spec.ClearField(image.FieldPullMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.PullTime(); ok {
+		_spec.SetField(image.FieldPullTime, field.TypeTime, value)
+	}
+	if _u.mutation.PullTimeCleared() {
+		_spec.ClearField(image.FieldPullTime, field.TypeTime)
+	}
+	if _u.mutation.ContainersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   image.ContainersTable,
+			Columns: []string{image.ContainersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContainersIDs(); len(nodes) > 0 && !_u.mutation.ContainersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   image.ContainersTable,
+			Columns: []string{image.ContainersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContainersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   image.ContainersTable,
+			Columns: []string{image.ContainersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Image{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{image.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// ContainersColumns holds the columns for the "containers" table.
+	ContainersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "container_status", Type: field.TypeEnum, Enums: []string{"PENDING", "UP", "REMOVED", "ERROR"}, Default: "PENDING"},
+		{Name: "container_id", Type: field.TypeString, Nullable: true},
+		{Name: "status_message", Type: field.TypeString, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "exit_time", Type: field.TypeTime, Nullable: true},
+		{Name: "image_containers", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "workspace_containers", Type: field.TypeInt},
+	}
+	// ContainersTable holds the schema information for the "containers" table.
+	ContainersTable = &schema.Table{
+		Name:       "containers",
+		Columns:    ContainersColumns,
+		PrimaryKey: []*schema.Column{ContainersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "containers_images_containers",
+				Columns:    []*schema.Column{ContainersColumns[6]},
+				RefColumns: []*schema.Column{ImagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "containers_users_containers",
+				Columns:    []*schema.Column{ContainersColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "containers_workspaces_containers",
+				Columns:    []*schema.Column{ContainersColumns[8]},
+				RefColumns: []*schema.Column{WorkspacesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ImagesColumns holds the columns for the "images" table.
+	ImagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "image_name", Type: field.TypeString},
+		{Name: "digest", Type: field.TypeString, Nullable: true},
+		{Name: "language", Type: field.TypeString},
+		{Name: "nano_cpus", Type: field.TypeInt64, Nullable: true},
+		{Name: "memory_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "pids_limit", Type: field.TypeInt64, Nullable: true},
+		{Name: "network_mode", Type: field.TypeEnum, Nullable: true, Enums: []string{"NONE", "INTERNAL", "EGRESS"}},
+		{Name: "pull_status", Type: field.TypeEnum, Enums: []string{"PENDING", "PULLING", "PULLED", "FAILED"}, Default: "PENDING"},
+		{Name: "pull_message", Type: field.TypeString, Nullable: true},
+		{Name: "pull_time", Type: field.TypeTime, Nullable: true},
+	}
+	// ImagesTable holds the schema information for the "images" table.
+	ImagesTable = &schema.Table{
+		Name:       "images",
+		Columns:    ImagesColumns,
+		PrimaryKey: []*schema.Column{ImagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "image_language",
+				Unique:  true,
+				Columns: []*schema.Column{ImagesColumns[3]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"USER", "ADMIN"}, Default: "USER"},
+		{Name: "create_time", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
+	// WorkspacesColumns holds the columns for the "workspaces" table.
+	WorkspacesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "uuid", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, Size: 64, Default: "untitled"},
+		{Name: "language", Type: field.TypeString},
+		{Name: "network_mode", Type: field.TypeEnum, Nullable: true, Enums: []string{"NONE", "INTERNAL", "EGRESS"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WorkspacesTable holds the schema information for the "workspaces" table.
+	WorkspacesTable = &schema.Table{
+		Name:       "workspaces",
+		Columns:    WorkspacesColumns,
+		PrimaryKey: []*schema.Column{WorkspacesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workspaces_users_workspaces",
+				Columns:    []*schema.Column{WorkspacesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		ContainersTable,
+		ImagesTable,
+		UsersTable,
+		WorkspacesTable,
+	}
+)
+
+func init() {
+	ContainersTable.ForeignKeys[0].RefTable = ImagesTable
+	ContainersTable.ForeignKeys[1].RefTable = UsersTable
+	ContainersTable.ForeignKeys[2].RefTable = WorkspacesTable
+	WorkspacesTable.ForeignKeys[0].RefTable = UsersTable
+}
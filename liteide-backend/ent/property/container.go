@@ -0,0 +1,22 @@
+package property
+
+// ContainerStatus 容器记录的生命周期状态
+type ContainerStatus string
+
+// 容器状态流转：Pending -> Up -> Removed，任一阶段失败则进入 Error
+const (
+	ContainerStatusPending ContainerStatus = "PENDING" // 已创建记录，等待容器实例启动
+	ContainerStatusUp      ContainerStatus = "UP"      // 容器实例运行中
+	ContainerStatusRemoved ContainerStatus = "REMOVED" // 容器实例已删除
+	ContainerStatusError   ContainerStatus = "ERROR"   // 创建或清理过程中出错
+)
+
+// Values 返回所有合法的枚举值，供 ent 生成枚举校验与数据库列定义
+func (ContainerStatus) Values() []string {
+	return []string{
+		string(ContainerStatusPending),
+		string(ContainerStatusUp),
+		string(ContainerStatusRemoved),
+		string(ContainerStatusError),
+	}
+}
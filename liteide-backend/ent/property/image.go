@@ -0,0 +1,22 @@
+package property
+
+// ImagePullStatus 镜像在 Swarm 节点上的预拉取状态
+type ImagePullStatus string
+
+// 可选的预拉取状态
+const (
+	ImagePullStatusPending ImagePullStatus = "PENDING" // 尚未拉取，或镜像配置变更后等待重新拉取
+	ImagePullStatusPulling ImagePullStatus = "PULLING" // 正在拉取
+	ImagePullStatusPulled  ImagePullStatus = "PULLED"  // 所有节点均已拉取成功
+	ImagePullStatusFailed  ImagePullStatus = "FAILED"  // 至少一个节点拉取失败
+)
+
+// Values 返回所有合法的枚举值，供 ent 生成枚举校验与数据库列定义
+func (ImagePullStatus) Values() []string {
+	return []string{
+		string(ImagePullStatusPending),
+		string(ImagePullStatusPulling),
+		string(ImagePullStatusPulled),
+		string(ImagePullStatusFailed),
+	}
+}
@@ -0,0 +1,21 @@
+package property
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Language 工作区与镜像所使用的编程语言名称，例如 C、PYTHON、GO
+// - 可用的语言由语言工具链注册表决定，数据库只校验名称格式
+type Language string
+
+// languagePattern 语言名称格式：大写字母开头，由大写字母、数字和下划线组成
+var languagePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,31}$`)
+
+// ValidateLanguage 校验语言名称格式
+func ValidateLanguage(language string) error {
+	if !languagePattern.MatchString(language) {
+		return fmt.Errorf("invalid language name: %q", language)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package property
+
+// NetworkMode 容器的网络隔离策略
+type NetworkMode string
+
+// 可选的网络隔离策略
+const (
+	NetworkModeNone     NetworkMode = "NONE"     // 不接入任何网络，无出站访问
+	NetworkModeInternal NetworkMode = "INTERNAL" // 仅接入内部 overlay 网络，无出站访问
+	NetworkModeEgress   NetworkMode = "EGRESS"   // 接入可访问外网的 overlay 网络
+)
+
+// Values 返回所有合法的枚举值，供 ent 生成枚举校验与数据库列定义
+func (NetworkMode) Values() []string {
+	return []string{
+		string(NetworkModeNone),
+		string(NetworkModeInternal),
+		string(NetworkModeEgress),
+	}
+}
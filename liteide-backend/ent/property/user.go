@@ -0,0 +1,18 @@
+package property
+
+// UserRole 用户角色
+type UserRole string
+
+// 可选的用户角色
+const (
+	UserRoleUser  UserRole = "USER"  // 普通用户
+	UserRoleAdmin UserRole = "ADMIN" // 管理员，可以管理镜像目录与用户
+)
+
+// Values 返回所有合法的枚举值，供 ent 生成枚举校验与数据库列定义
+func (UserRole) Values() []string {
+	return []string{
+		string(UserRoleUser),
+		string(UserRoleAdmin),
+	}
+}
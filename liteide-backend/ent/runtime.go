@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/schema"
+	"liteide-backend/ent/user"
+	"liteide-backend/ent/workspace"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	containerFields := schema.Container{}.Fields()
+	_ = containerFields
+	// containerDescCreateTime is the schema descriptor for create_time field.
+	containerDescCreateTime := containerFields[4].Descriptor()
+	// container.DefaultCreateTime holds the default value on creation for the create_time field.
+	container.DefaultCreateTime = containerDescCreateTime.Default.(func() time.Time)
+	imageFields := schema.Image{}.Fields()
+	_ = imageFields
+	// imageDescImageName is the schema descriptor for image_name field.
+	imageDescImageName := imageFields[0].Descriptor()
+	// image.ImageNameValidator is a validator for the "image_name" field. It is called by the builders before save.
+	image.ImageNameValidator = imageDescImageName.Validators[0].(func(string) error)
+	// imageDescDigest is the schema descriptor for digest field.
+	imageDescDigest := imageFields[1].Descriptor()
+	// image.DigestValidator is a validator for the "digest" field. It is called by the builders before save.
+	image.DigestValidator = imageDescDigest.Validators[0].(func(string) error)
+	// imageDescLanguage is the schema descriptor for language field.
+	imageDescLanguage := imageFields[2].Descriptor()
+	// image.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	image.LanguageValidator = imageDescLanguage.Validators[0].(func(string) error)
+	// imageDescNanoCpus is the schema descriptor for nano_cpus field.
+	imageDescNanoCpus := imageFields[3].Descriptor()
+	// image.NanoCpusValidator is a validator for the "nano_cpus" field. It is called by the builders before save.
+	image.NanoCpusValidator = imageDescNanoCpus.Validators[0].(func(int64) error)
+	// imageDescMemoryBytes is the schema descriptor for memory_bytes field.
+	imageDescMemoryBytes := imageFields[4].Descriptor()
+	// image.MemoryBytesValidator is a validator for the "memory_bytes" field. It is called by the builders before save.
+	image.MemoryBytesValidator = imageDescMemoryBytes.Validators[0].(func(int64) error)
+	// imageDescPidsLimit is the schema descriptor for pids_limit field.
+	imageDescPidsLimit := imageFields[5].Descriptor()
+	// image.PidsLimitValidator is a validator for the "pids_limit" field. It is called by the builders before save.
+	image.PidsLimitValidator = imageDescPidsLimit.Validators[0].(func(int64) error)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = func() func(string) error {
+		validators := userDescUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(username string) error {
+			for _, fn := range fns {
+				if err := fn(username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescCreateTime is the schema descriptor for create_time field.
+	userDescCreateTime := userFields[3].Descriptor()
+	// user.DefaultCreateTime holds the default value on creation for the create_time field.
+	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
+	workspaceFields := schema.Workspace{}.Fields()
+	_ = workspaceFields
+	// workspaceDescUUID is the schema descriptor for uuid field.
+	workspaceDescUUID := workspaceFields[0].Descriptor()
+	// workspace.DefaultUUID holds the default value on creation for the uuid field.
+	workspace.DefaultUUID = workspaceDescUUID.Default.(func() uuid.UUID)
+	// workspaceDescName is the schema descriptor for name field.
+	workspaceDescName := workspaceFields[2].Descriptor()
+	// workspace.DefaultName holds the default value on creation for the name field.
+	workspace.DefaultName = workspaceDescName.Default.(string)
+	// workspace.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	workspace.NameValidator = func() func(string) error {
+		validators := workspaceDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workspaceDescLanguage is the schema descriptor for language field.
+	workspaceDescLanguage := workspaceFields[3].Descriptor()
+	// workspace.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	workspace.LanguageValidator = workspaceDescLanguage.Validators[0].(func(string) error)
+	// workspaceDescCreateTime is the schema descriptor for create_time field.
+	workspaceDescCreateTime := workspaceFields[5].Descriptor()
+	// workspace.DefaultCreateTime holds the default value on creation for the create_time field.
+	workspace.DefaultCreateTime = workspaceDescCreateTime.Default.(func() time.Time)
+}
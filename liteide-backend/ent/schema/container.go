@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"liteide-backend/ent/property"
+	"time"
+)
+
+// Container 容器记录，对应一个容器运行时中的实例
+type Container struct {
+	ent.Schema
+}
+
+// Fields 定义容器的字段
+func (Container) Fields() []ent.Field {
+	return []ent.Field{
+		// 创建容器的用户 ID
+		// - 可为空：引入用户前创建的容器没有所有者，启动时由 AssignOwnerlessRecords 归属管理员
+		field.Int("user_id").
+			Optional(),
+		// 容器生命周期状态
+		field.Enum("container_status").
+			GoType(property.ContainerStatus("")).
+			Default(string(property.ContainerStatusPending)),
+		// 运行时实例 ID（Swarm 服务 ID 或 Docker 容器 ID），实例运行时才存在
+		field.String("container_id").
+			Optional().
+			Nillable(),
+		// 状态说明，例如容器启动失败的原因
+		field.String("status_message").
+			Optional().
+			Nillable(),
+		// 记录创建时间
+		field.Time("create_time").
+			Default(time.Now).
+			Immutable(),
+		// 容器删除时间
+		field.Time("exit_time").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges 定义容器的关联关系
+func (Container) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 创建容器的用户
+		edge.From("user", User.Type).
+			Ref("containers").
+			Field("user_id").
+			Unique(),
+		// 容器使用的镜像
+		edge.From("image", Image.Type).
+			Ref("containers").
+			Unique().
+			Required(),
+		// 容器挂载的工作区
+		edge.From("workspace", Workspace.Type).
+			Ref("containers").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"liteide-backend/ent/property"
+	"regexp"
+)
+
+// Image 容器镜像，按编程语言选择
+type Image struct {
+	ent.Schema
+}
+
+// Fields 定义镜像的字段
+func (Image) Fields() []ent.Field {
+	return []ent.Field{
+		// Docker 镜像名称，例如 gcc:latest
+		field.String("image_name").
+			NotEmpty(),
+		// 镜像摘要，例如 sha256:…，设置后按摘要固定镜像版本
+		field.String("digest").
+			Optional().
+			Nillable().
+			Match(regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)),
+		// 镜像对应的编程语言
+		field.String("language").
+			GoType(property.Language("")).
+			Validate(property.ValidateLanguage),
+		// CPU 上限（单位：1e-9 核），为空时使用全局默认值
+		field.Int64("nano_cpus").
+			Optional().
+			Nillable().
+			Positive(),
+		// 内存上限（字节），为空时使用全局默认值
+		field.Int64("memory_bytes").
+			Optional().
+			Nillable().
+			Positive(),
+		// 进程数上限，为空时使用全局默认值
+		field.Int64("pids_limit").
+			Optional().
+			Nillable().
+			Positive(),
+		// 网络隔离策略，为空时使用全局默认值
+		field.Enum("network_mode").
+			GoType(property.NetworkMode("")).
+			Optional().
+			Nillable(),
+		// 镜像在 Swarm 节点上的预拉取状态
+		field.Enum("pull_status").
+			GoType(property.ImagePullStatus("")).
+			Default(string(property.ImagePullStatusPending)),
+		// 最近一次预拉取的失败原因
+		field.String("pull_message").
+			Optional().
+			Nillable(),
+		// 最近一次预拉取完成的时间
+		field.Time("pull_time").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges 定义镜像的关联关系
+func (Image) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 使用该镜像创建的容器
+		edge.To("containers", Container.Type),
+	}
+}
+
+// Indexes 定义镜像的索引
+func (Image) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每种语言只有一个镜像
+		index.Fields("language").
+			Unique(),
+	}
+}
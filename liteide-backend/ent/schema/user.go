@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"liteide-backend/ent/property"
+	"regexp"
+	"time"
+)
+
+// User 用户
+type User struct {
+	ent.Schema
+}
+
+// Fields 定义用户的字段
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		// 登录名，字母、数字、下划线、点或短横线
+		field.String("username").
+			Unique().
+			MaxLen(32).
+			Match(regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)),
+		// bcrypt 密码哈希，不会出现在日志与 String() 中
+		field.String("password_hash").
+			Sensitive(),
+		// 用户角色
+		field.Enum("role").
+			GoType(property.UserRole("")).
+			Default(string(property.UserRoleUser)),
+		// 用户创建时间
+		field.Time("create_time").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义用户的关联关系
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 用户拥有的工作区，仍有工作区时不允许删除用户
+		edge.To("workspaces", Workspace.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)),
+		// 用户创建的容器，仍有容器记录时不允许删除用户
+		edge.To("containers", Container.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"liteide-backend/ent/property"
+	"time"
+)
+
+// Workspace 工作区，对应 DataDirectory/workspace/<uuid> 目录
+type Workspace struct {
+	ent.Schema
+}
+
+// Fields 定义工作区的字段
+func (Workspace) Fields() []ent.Field {
+	return []ent.Field{
+		// 工作区目录名，创建后不可修改
+		field.UUID("uuid", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		// 所有者的用户 ID
+		// - 可为空：引入用户前创建的工作区没有所有者，启动时由 AssignOwnerlessRecords 归属管理员
+		field.Int("user_id").
+			Optional(),
+		// 工作区显示名称，可重命名
+		field.String("name").
+			Default("untitled").
+			NotEmpty().
+			MaxLen(64),
+		// 工作区使用的编程语言，决定容器镜像
+		field.String("language").
+			GoType(property.Language("")).
+			Validate(property.ValidateLanguage),
+		// 网络隔离策略，优先于镜像配置，为空时使用镜像配置
+		field.Enum("network_mode").
+			GoType(property.NetworkMode("")).
+			Optional().
+			Nillable(),
+		// 记录创建时间
+		field.Time("create_time").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义工作区的关联关系
+func (Workspace) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 工作区的所有者
+		edge.From("user", User.Type).
+			Ref("workspaces").
+			Field("user_id").
+			Unique(),
+		// 基于该工作区创建的容器
+		edge.To("containers", Container.Type),
+	}
+}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/user"
+	"liteide-backend/ent/workspace"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Workspace is the model entity for the Workspace schema.
+type Workspace struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UUID holds the value of the "uuid" field.
+	UUID uuid.UUID `json:"uuid,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Language holds the value of the "language" field.
+	Language property.Language `json:"language,omitempty"`
+	// NetworkMode holds the value of the "network_mode" field.
+	NetworkMode *property.NetworkMode `json:"network_mode,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkspaceQuery when eager-loading is set.
+	Edges        WorkspaceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkspaceEdges holds the relations/edges for other nodes in the graph.
+type WorkspaceEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Containers holds the value of the containers edge.
+	Containers []*Container `json:"containers,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkspaceEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ContainersOrErr returns the Containers value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkspaceEdges) ContainersOrErr() ([]*Container, error) {
+	if e.loadedTypes[1] {
+		return e.Containers, nil
+	}
+	return nil, &NotLoadedError{edge: "containers"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Workspace) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workspace.FieldID, workspace.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case workspace.FieldName, workspace.FieldLanguage, workspace.FieldNetworkMode:
+			values[i] = new(sql.NullString)
+		case workspace.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		case workspace.FieldUUID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Workspace fields.
+func (_m *Workspace) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workspace.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case workspace.FieldUUID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field uuid", values[i])
+			} else if value != nil {
+				_m.UUID = *value
+			}
+		case workspace.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case workspace.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case workspace.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = property.Language(value.String)
+			}
+		case workspace.FieldNetworkMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field network_mode", values[i])
+			} else if value.Valid {
+				_m.NetworkMode = new(property.NetworkMode)
+				*_m.NetworkMode = property.NetworkMode(value.String)
+			}
+		case workspace.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Workspace.
+// This includes values selected through modifiers, order, etc.
+func (_m *Workspace) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Workspace entity.
+func (_m *Workspace) QueryUser() *UserQuery {
+	return NewWorkspaceClient(_m.config).QueryUser(_m)
+}
+
+// QueryContainers queries the "containers" edge of the Workspace entity.
+func (_m *Workspace) QueryContainers() *ContainerQuery {
+	return NewWorkspaceClient(_m.config).QueryContainers(_m)
+}
+
+// Update returns a builder for updating this Workspace.
+// Note that you need to call Workspace.Unwrap() before calling this method if this Workspace
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Workspace) Update() *WorkspaceUpdateOne {
+	return NewWorkspaceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Workspace entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Workspace) Unwrap() *Workspace {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Workspace is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Workspace) String() string {
+	var builder strings.Builder
+	builder.WriteString("Workspace(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("uuid=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UUID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Language))
+	builder.WriteString(", ")
+	if v := _m.NetworkMode; v != nil {
+		builder.WriteString("network_mode=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Workspaces is a parsable slice of Workspace.
+type Workspaces []*Workspace
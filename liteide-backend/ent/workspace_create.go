@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/user"
+	"liteide-backend/ent/workspace"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WorkspaceCreate is the builder for creating a Workspace entity.
+type WorkspaceCreate struct {
+	config
+	mutation *WorkspaceMutation
+	hooks    []Hook
+}
+
+// SetUUID sets the "uuid" field.
+func (_c *WorkspaceCreate) SetUUID(v uuid.UUID) *WorkspaceCreate {
+	_c.mutation.SetUUID(v)
+	return _c
+}
+
+// SetNillableUUID sets the "uuid" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableUUID(v *uuid.UUID) *WorkspaceCreate {
+	if v != nil {
+		_c.SetUUID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WorkspaceCreate) SetUserID(v int) *WorkspaceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableUserID(v *int) *WorkspaceCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WorkspaceCreate) SetName(v string) *WorkspaceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableName(v *string) *WorkspaceCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *WorkspaceCreate) SetLanguage(v property.Language) *WorkspaceCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNetworkMode sets the "network_mode" field.
+func (_c *WorkspaceCreate) SetNetworkMode(v property.NetworkMode) *WorkspaceCreate {
+	_c.mutation.SetNetworkMode(v)
+	return _c
+}
+
+// SetNillableNetworkMode sets the "network_mode" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableNetworkMode(v *property.NetworkMode) *WorkspaceCreate {
+	if v != nil {
+		_c.SetNetworkMode(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *WorkspaceCreate) SetCreateTime(v time.Time) *WorkspaceCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableCreateTime(v *time.Time) *WorkspaceCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *WorkspaceCreate) SetUser(v *User) *WorkspaceCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddContainerIDs adds the "containers" edge to the Container entity by IDs.
+func (_c *WorkspaceCreate) AddContainerIDs(ids ...int) *WorkspaceCreate {
+	_c.mutation.AddContainerIDs(ids...)
+	return _c
+}
+
+// AddContainers adds the "containers" edges to the Container entity.
+func (_c *WorkspaceCreate) AddContainers(v ...*Container) *WorkspaceCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContainerIDs(ids...)
+}
+
+// Mutation returns the WorkspaceMutation object of the builder.
+func (_c *WorkspaceCreate) Mutation() *WorkspaceMutation {
+	return _c.mutation
+}
+
+// Save creates the Workspace in the database.
+func (_c *WorkspaceCreate) Save(ctx context.Context) (*Workspace, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkspaceCreate) SaveX(ctx context.Context) *Workspace {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkspaceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkspaceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkspaceCreate) defaults() {
+	if _, ok := _c.mutation.UUID(); !ok {
+		v := workspace.DefaultUUID()
+		_c.mutation.SetUUID(v)
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		v := workspace.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := workspace.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkspaceCreate) check() error {
+	if _, ok := _c.mutation.UUID(); !ok {
+		return &ValidationError{Name: "uuid", err: errors.New(`ent: missing required field "Workspace.uuid"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Workspace.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := workspace.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Workspace.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`ent: missing required field "Workspace.language"`)}
+	}
+	if v, ok := _c.mutation.Language(); ok {
+		if err := workspace.LanguageValidator(string(v)); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Workspace.language": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.NetworkMode(); ok {
+		if err := workspace.NetworkModeValidator(v); err != nil {
+			return &ValidationError{Name: "network_mode", err: fmt.Errorf(`ent: validator failed for field "Workspace.network_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Workspace.create_time"`)}
+	}
+	return nil
+}
+
+func (_c *WorkspaceCreate) sqlSave(ctx context.Context) (*Workspace, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkspaceCreate) createSpec() (*Workspace, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Workspace{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(workspace.Table, sqlgraph.NewFieldSpec(workspace.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UUID(); ok {
+		_spec.SetField(workspace.FieldUUID, field.TypeUUID, value)
+		_node.UUID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(workspace.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(workspace.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.NetworkMode(); ok {
+		_spec.SetField(workspace.FieldNetworkMode, field.TypeEnum, value)
+		_node.NetworkMode = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(workspace.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workspace.UserTable,
+			Columns: []string{workspace.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContainersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workspace.ContainersTable,
+			Columns: []string{workspace.ContainersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(container.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkspaceCreateBulk is the builder for creating many Workspace entities in bulk.
+type WorkspaceCreateBulk struct {
+	config
+	err      error
+	builders []*WorkspaceCreate
+}
+
+// Save creates the Workspace entities in the database.
+func (_c *WorkspaceCreateBulk) Save(ctx context.Context) ([]*Workspace, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Workspace, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkspaceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkspaceCreateBulk) SaveX(ctx context.Context) []*Workspace {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkspaceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkspaceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"liteide-backend/config"
+	"liteide-backend/ent"
+	"liteide-backend/ent/property"
+	"strings"
+	"testing"
+)
+
+func TestDSN(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    config.DatabaseConfig
+		want    string
+		wantErr bool
+	}{
+		{name: "mysql", conf: config.DatabaseConfig{Driver: config.DatabaseMySQL, Username: "root", Password: "123456", Address: "localhost:3306", Database: "liteide"},
+			want: "root:123456@tcp(localhost:3306)/liteide?parseTime=true"},
+		{name: "postgres", conf: config.DatabaseConfig{Driver: config.DatabasePostgres, Username: "alice", Password: "p@ss/word", Address: "db:5432", Database: "liteide"},
+			want: "postgres://alice:p%40ss%2Fword@db:5432/liteide"},
+		{name: "postgres without user", conf: config.DatabaseConfig{Driver: config.DatabasePostgres, Address: "db:5432", Database: "liteide"},
+			want: "postgres://db:5432/liteide"},
+		{name: "sqlite file", conf: config.DatabaseConfig{Driver: config.DatabaseSQLite, Database: "/data/liteide.db"},
+			want: "file:/data/liteide.db?_fk=1"},
+		{name: "sqlite without file", conf: config.DatabaseConfig{Driver: config.DatabaseSQLite}, wantErr: true},
+		{name: "unknown driver", conf: config.DatabaseConfig{Driver: "oracle"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DSN(tt.conf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DSN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DSN() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDSNSQLiteMemory(t *testing.T) {
+	conf := config.DatabaseConfig{Driver: config.DatabaseSQLite, Database: config.SQLiteMemory}
+	first, err := DSN(conf)
+	if err != nil {
+		t.Fatalf("DSN() error = %v", err)
+	}
+	if !strings.HasPrefix(first, "file:liteide-") || !strings.HasSuffix(first, "?mode=memory&cache=shared&_fk=1") {
+		t.Errorf("DSN() = %q, want a shared-cache memory database", first)
+	}
+	if second, _ := DSN(conf); second == first {
+		t.Errorf("DSN() returned %q twice, want a unique memory database per call", first)
+	}
+}
+
+func TestOpenSQLiteMemory(t *testing.T) {
+	client, err := Open(config.DatabaseConfig{Driver: config.DatabaseSQLite, Database: config.SQLiteMemory})
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer client.Close()
+	Migrate(client)
+
+	// 事务与普通查询可能使用不同的连接，均应访问同一个内存数据库
+	ctx := context.Background()
+	err = WithTx(client, ctx, func(client *ent.Client, ctx context.Context) error {
+		return client.User.Create().SetUsername("alice").SetPasswordHash("-").SetRole(property.UserRoleUser).Exec(ctx)
+	})
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	if count := client.User.Query().CountX(ctx); count != 1 {
+		t.Errorf("user count = %d, want 1", count)
+	}
+
+	// 另一个客户端打开的是独立的内存数据库
+	other, err := Open(config.DatabaseConfig{Driver: config.DatabaseSQLite, Database: config.SQLiteMemory})
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer other.Close()
+	Migrate(other)
+	if count := other.User.Query().CountX(ctx); count != 0 {
+		t.Errorf("user count of another client = %d, want 0", count)
+	}
+
+	if _, err := Open(config.DatabaseConfig{Driver: "oracle"}); err == nil {
+		t.Error("Open() with unknown driver should fail")
+	}
+}
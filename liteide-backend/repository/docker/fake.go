@@ -0,0 +1,390 @@
+package docker
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+	"io"
+	"net"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FakeRuntime 可以编程注入失败的操作名称
+const (
+	FakeOpCreate      = "Create"
+	FakeOpWaitRunning = "WaitRunning"
+	FakeOpRemove      = "Remove"
+	FakeOpInspect     = "Inspect"
+	FakeOpList        = "List"
+	FakeOpNetwork     = "EnsureNetwork"
+	FakeOpPull        = "PullImage"
+	FakeOpExecCreate  = "ExecCreate"
+	FakeOpExecAttach  = "ExecAttach"
+)
+
+// FakeRuntime 内存中的容器运行时，用于测试与离线开发
+// - 实例只记录在内存中，不创建任何容器
+// - Exec 在本机以工作区目录为工作目录启动进程，容器内的 /workspace 映射为工作区目录
+// - 通过 FailOn 为指定操作注入错误
+type FakeRuntime struct {
+	mu        sync.Mutex
+	nextId    int
+	instances map[string]*fakeInstance
+	execs     map[string]*fakeExec
+	networks  map[string]bool // 已创建的网络名称 -> 是否禁止出站访问
+	failures  map[string]error
+	delay     time.Duration // 新建实例进入运行状态前的启动时间
+}
+
+// fakeInstance 内存中的容器实例
+type fakeInstance struct {
+	spec    Spec
+	state   State
+	readyAt time.Time // 启动中的实例进入运行状态的时间
+}
+
+// refresh 启动时间已到时将启动中的实例标记为运行中
+func (i *fakeInstance) refresh() {
+	if i.state.State == "starting" && !time.Now().Before(i.readyAt) {
+		i.state = State{State: "running", Running: true, UpdateTime: i.readyAt}
+	}
+}
+
+// fakeExec 本机进程模拟的 Exec 进程
+type fakeExec struct {
+	instance string
+	config   ExecConfig
+	started  bool
+	done     bool
+	exitCode int
+}
+
+// NewFakeRuntime 创建内存中的容器运行时
+func NewFakeRuntime() *FakeRuntime {
+	return &FakeRuntime{
+		instances: make(map[string]*fakeInstance),
+		execs:     make(map[string]*fakeExec),
+		networks:  make(map[string]bool),
+		failures:  make(map[string]error),
+	}
+}
+
+// FailOn 使操作 `op` 此后都返回 `err`，`err` 为 nil 时恢复正常
+func (r *FakeRuntime) FailOn(op string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil {
+		delete(r.failures, op)
+		return
+	}
+	r.failures[op] = err
+}
+
+// Spec 返回实例创建时的配置，实例不存在时返回 false
+func (r *FakeRuntime) Spec(id string) (Spec, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	instance, ok := r.instances[id]
+	if !ok {
+		return Spec{}, false
+	}
+	return instance.spec, true
+}
+
+// Stop 将实例标记为已退出，模拟容器在运行时中异常退出
+func (r *FakeRuntime) Stop(id string, message string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if instance, ok := r.instances[id]; ok {
+		instance.state = State{State: "exited", Message: message, UpdateTime: time.Now()}
+	}
+}
+
+// SetState 设置实例的状态，模拟实例进入重启中等过渡状态
+func (r *FakeRuntime) SetState(id string, state State) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if instance, ok := r.instances[id]; ok {
+		instance.state = state
+	}
+}
+
+// SetStartDelay 设置此后新建实例的启动时间，实例在启动时间内处于 starting 状态
+func (r *FakeRuntime) SetStartDelay(delay time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.delay = delay
+}
+
+// Create 在内存中创建实例，未设置启动时间时实例立即处于运行状态
+func (r *FakeRuntime) Create(_ context.Context, spec Spec) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.failures[FakeOpCreate]; err != nil {
+		return "", err
+	}
+	for _, instance := range r.instances {
+		if instance.spec.Name == spec.Name {
+			return "", fmt.Errorf("instance %s already exists", spec.Name)
+		}
+	}
+
+	r.nextId++
+	id := "fake-" + strconv.Itoa(r.nextId)
+	instance := &fakeInstance{
+		spec:    spec,
+		state:   State{State: "starting", UpdateTime: time.Now()},
+		readyAt: time.Now().Add(r.delay),
+	}
+	instance.refresh()
+	r.instances[id] = instance
+	return id, nil
+}
+
+// WaitRunning 等待实例进入运行状态
+// - 实例已终止或超时时返回 ErrStartFailed
+func (r *FakeRuntime) WaitRunning(ctx context.Context, id string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		r.mu.Lock()
+		if err := r.failures[FakeOpWaitRunning]; err != nil {
+			r.mu.Unlock()
+			return err
+		}
+		instance, ok := r.instances[id]
+		if !ok {
+			r.mu.Unlock()
+			return ErrNotFound
+		}
+		instance.refresh()
+		state := instance.state
+		r.mu.Unlock()
+
+		if state.Running {
+			return nil
+		}
+		if state.Terminated() {
+			return fmt.Errorf("%w: container %s: %s", ErrStartFailed, state.State, state.Message)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: timed out after %v", ErrStartFailed, timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Remove 删除内存中的实例
+func (r *FakeRuntime) Remove(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.failures[FakeOpRemove]; err != nil {
+		return err
+	}
+	if _, ok := r.instances[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.instances, id)
+	return nil
+}
+
+// Inspect 查询实例状态
+func (r *FakeRuntime) Inspect(_ context.Context, id string) (*State, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.failures[FakeOpInspect]; err != nil {
+		return nil, err
+	}
+	instance, ok := r.instances[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	instance.refresh()
+	state := instance.state
+	return &state, nil
+}
+
+// List 列出名称以 `prefix` 开头的实例
+func (r *FakeRuntime) List(_ context.Context, prefix string) ([]Instance, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.failures[FakeOpList]; err != nil {
+		return nil, err
+	}
+	instances := make([]Instance, 0, len(r.instances))
+	for id, instance := range r.instances {
+		if strings.HasPrefix(instance.spec.Name, prefix) {
+			instances = append(instances, Instance{ID: id, Name: instance.spec.Name})
+		}
+	}
+	return instances, nil
+}
+
+// EnsureNetwork 在内存中记录网络
+func (r *FakeRuntime) EnsureNetwork(_ context.Context, name string, internal bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.failures[FakeOpNetwork]; err != nil {
+		return err
+	}
+	r.networks[name] = internal
+	return nil
+}
+
+// Network 查询通过 EnsureNetwork 创建的网络，返回网络是否禁止出站访问
+func (r *FakeRuntime) Network(name string) (internal bool, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	internal, ok = r.networks[name]
+	return internal, ok
+}
+
+// PullImage 不拉取任何镜像
+func (r *FakeRuntime) PullImage(context.Context, string, string, time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failures[FakeOpPull]
+}
+
+// ExecCreate 记录 Exec 配置，实例不存在或未运行时返回 ErrNotFound
+func (r *FakeRuntime) ExecCreate(_ context.Context, id string, config ExecConfig) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.failures[FakeOpExecCreate]; err != nil {
+		return "", err
+	}
+	instance, ok := r.instances[id]
+	if !ok {
+		return "", ErrNotFound
+	}
+	instance.refresh()
+	if !instance.state.Running {
+		return "", ErrNotFound
+	}
+
+	r.nextId++
+	execId := "fake-exec-" + strconv.Itoa(r.nextId)
+	r.execs[execId] = &fakeExec{instance: id, config: config}
+	return execId, nil
+}
+
+// ExecAttach 在本机启动 Exec 进程，并通过内存管道连接其输入输出
+// - 非 TTY 模式下按 Docker 的多路复用格式输出，与真实运行时一致
+func (r *FakeRuntime) ExecAttach(_ context.Context, execId string, tty bool) (types.HijackedResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.failures[FakeOpExecAttach]; err != nil {
+		return types.HijackedResponse{}, err
+	}
+	e, ok := r.execs[execId]
+	if !ok {
+		return types.HijackedResponse{}, ErrNotFound
+	}
+	if e.started {
+		return types.HijackedResponse{}, errors.New("exec already started")
+	}
+	instance, ok := r.instances[e.instance]
+	if !ok || len(e.config.Cmd) == 0 {
+		return types.HijackedResponse{}, ErrNotFound
+	}
+
+	cmd := exec.Command(e.config.Cmd[0], e.config.Cmd[1:]...)
+	cmd.Dir = workspacePath(instance.spec.WorkspaceDir, e.config.WorkingDir)
+
+	server, client := net.Pipe()
+	if tty {
+		cmd.Stdout, cmd.Stderr = server, server
+	} else {
+		cmd.Stdout = stdcopy.NewStdWriter(server, stdcopy.Stdout)
+		cmd.Stderr = stdcopy.NewStdWriter(server, stdcopy.Stderr)
+	}
+	var stdin io.WriteCloser
+	if e.config.AttachStdin {
+		var err error
+		if stdin, err = cmd.StdinPipe(); err != nil {
+			return types.HijackedResponse{}, err
+		}
+	}
+	if err := cmd.Start(); err != nil {
+		return types.HijackedResponse{}, err
+	}
+	e.started = true
+
+	// 转发输入，连接关闭时关闭进程的标准输入
+	if stdin != nil {
+		go func() {
+			_, _ = io.Copy(stdin, server)
+			_ = stdin.Close()
+		}()
+	}
+	// 进程退出后记录退出码并关闭连接
+	go func() {
+		err := cmd.Wait()
+		r.mu.Lock()
+		e.done = true
+		e.exitCode = exitCode(cmd, err)
+		r.mu.Unlock()
+		_ = server.Close()
+	}()
+
+	return types.HijackedResponse{Conn: client, Reader: bufio.NewReader(client)}, nil
+}
+
+// ExecInspect 查询 Exec 进程的状态
+func (r *FakeRuntime) ExecInspect(_ context.Context, execId string) (*ExecStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.execs[execId]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &ExecStatus{Running: e.started && !e.done, ExitCode: e.exitCode}, nil
+}
+
+// ExecResize 本机进程没有 TTY，忽略尺寸调整
+func (r *FakeRuntime) ExecResize(_ context.Context, execId string, _ uint, _ uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.execs[execId]; !ok {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// workspacePath 将容器内的工作目录映射为本机路径
+// - /workspace 的子目录映射到工作区目录下的对应目录，其余路径均映射为工作区目录
+func workspacePath(workspaceDir string, workingDir string) string {
+	if rel, ok := strings.CutPrefix(workingDir, "/workspace/"); ok {
+		return filepath.Join(workspaceDir, filepath.FromSlash(rel))
+	}
+	return workspaceDir
+}
+
+// exitCode 返回与 Docker 一致的退出码：被信号终止时为 128+信号值
+func exitCode(cmd *exec.Cmd, err error) int {
+	if cmd.ProcessState == nil {
+		if err != nil {
+			return -1
+		}
+		return 0
+	}
+	if status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return 128 + int(status.Signal())
+	}
+	return cmd.ProcessState.ExitCode()
+}
@@ -0,0 +1,630 @@
+package docker
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/gofiber/fiber/v2/log"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+	"liteide-backend/config"
+	"maps"
+	"net"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Kubernetes 资源使用的标签
+const (
+	kubeLabelManagedBy = "app.kubernetes.io/managed-by" // 标记由本后端创建的资源
+	kubeLabelNetwork   = "liteide.io/network"           // Pod 接入的网络，NetworkPolicy 据此选择 Pod
+	kubeManagedBy      = "liteide-backend"
+	kubeContainerName  = "workspace" // Pod 内唯一容器的名称
+)
+
+// kubeStartFailures 容器处于这些等待原因时不会自行恢复，视为启动失败
+var kubeStartFailures = []string{
+	"ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CrashLoopBackOff",
+	"CreateContainerConfigError", "CreateContainerError", "RunContainerError",
+}
+
+// PodExecutor 通过 Pod 的 exec 子资源执行命令
+// - 与 API Server 的流式连接无法由 fake clientset 模拟，测试时可替换为其他实现
+type PodExecutor interface {
+	Stream(ctx context.Context, namespace string, pod string, options *corev1.PodExecOptions, streams remotecommand.StreamOptions) error
+}
+
+// spdyExecutor 基于 SPDY 协议的 PodExecutor
+type spdyExecutor struct {
+	config    *rest.Config
+	clientset kubernetes.Interface
+}
+
+// NewSPDYExecutor 创建基于 SPDY 协议的 PodExecutor
+func NewSPDYExecutor(restConfig *rest.Config, clientset kubernetes.Interface) PodExecutor {
+	return spdyExecutor{config: restConfig, clientset: clientset}
+}
+
+// Stream 建立 exec 连接并转发输入输出，直到命令退出
+func (e spdyExecutor) Stream(ctx context.Context, namespace string, pod string, options *corev1.PodExecOptions, streams remotecommand.StreamOptions) error {
+	request := e.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(options, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(e.config, "POST", request.URL())
+	if err != nil {
+		return err
+	}
+	return executor.StreamWithContext(ctx, streams)
+}
+
+// InitKubernetes 初始化 Kubernetes 客户端
+// - `kubeconfig`：kubeconfig 文件路径，为空时使用集群内配置
+func InitKubernetes(kubeconfig string) (*rest.Config, kubernetes.Interface, error) {
+	var restConfig *rest.Config
+	var err error
+	if kubeconfig == "" {
+		restConfig, err = rest.InClusterConfig()
+	} else {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load kubernetes config: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return restConfig, clientset, nil
+}
+
+// KubernetesRuntime 基于 Kubernetes 的容器运行时
+// - 每个容器对应一个 Pod，实例 ID 为 Pod 名称
+// - 工作区通过共享 PVC 的子目录挂载；未配置 PVC 时使用 hostPath，要求后端与 Pod 位于同一节点
+// - 网络隔离通过 NetworkPolicy 实现，需要集群的网络插件支持
+type KubernetesRuntime struct {
+	clientset     kubernetes.Interface
+	executor      PodExecutor
+	conf          config.KubernetesConfig
+	dataDirectory string // 后端挂载 PVC 的目录，用于计算工作区在 PVC 中的子目录
+
+	mu            sync.Mutex
+	nextId        int
+	execs         map[string]*kubeExec
+	execRetention time.Duration // 已结束或未启动的 Exec 记录的保留时间，超过后在创建新 Exec 时清理
+}
+
+// kubeExecRetention Exec 记录的默认保留时间，足够调用方在命令结束后查询退出码
+const kubeExecRetention = 5 * time.Minute
+
+// kubeExec 通过 exec 子资源执行的进程
+type kubeExec struct {
+	pod        string
+	config     ExecConfig
+	started    bool
+	done       bool
+	exitCode   int
+	sizes      chan remotecommand.TerminalSize
+	updateTime time.Time // 创建时间，命令结束后为结束时间
+}
+
+// Next 返回下一次 TTY 尺寸调整，连接结束时返回 nil
+func (e *kubeExec) Next() *remotecommand.TerminalSize {
+	size, ok := <-e.sizes
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+// NewKubernetesRuntime 创建基于 Kubernetes 的容器运行时
+// - `clientset`：Kubernetes 客户端，测试时可使用 fake clientset
+// - `executor`：执行 exec 子资源的 PodExecutor
+// - `conf`：Kubernetes 运行时配置
+// - `dataDirectory`：应用数据目录，使用 PVC 时即 PVC 在后端的挂载目录
+func NewKubernetesRuntime(clientset kubernetes.Interface, executor PodExecutor, conf config.KubernetesConfig, dataDirectory string) *KubernetesRuntime {
+	return &KubernetesRuntime{
+		clientset:     clientset,
+		executor:      executor,
+		conf:          conf,
+		dataDirectory: dataDirectory,
+		execs:         make(map[string]*kubeExec),
+		execRetention: kubeExecRetention,
+	}
+}
+
+// Create 创建 Pod
+func (r *KubernetesRuntime) Create(ctx context.Context, spec Spec) (string, error) {
+	volume, err := r.workspaceVolume(spec.WorkspaceDir)
+	if err != nil {
+		return "", err
+	}
+	if spec.Network == NetworkNone {
+		// 不接入网络的 Pod 由拒绝所有流量的 NetworkPolicy 隔离
+		if err := r.ensurePolicy(ctx, NetworkNone, nil); err != nil {
+			return "", err
+		}
+	}
+
+	// Kubernetes 没有单个 Pod 的进程数限制，PidsLimit 由节点的 kubelet 配置决定
+	requests := corev1.ResourceList{}
+	if spec.Resources.NanoCPUReservation > 0 {
+		requests[corev1.ResourceCPU] = *resource.NewMilliQuantity(spec.Resources.NanoCPUReservation/1e6, resource.DecimalSI)
+	}
+	if spec.Resources.MemoryReservation > 0 {
+		requests[corev1.ResourceMemory] = *resource.NewQuantity(spec.Resources.MemoryReservation, resource.BinarySI)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      spec.Name,
+			Namespace: r.conf.Namespace,
+			Labels: map[string]string{
+				kubeLabelManagedBy: kubeManagedBy,
+				kubeLabelNetwork:   spec.Network,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                corev1.RestartPolicyAlways,
+			AutomountServiceAccountToken: new(bool), // 容器内不需要访问 API Server
+			Containers: []corev1.Container{
+				{
+					Name:       kubeContainerName,
+					Image:      spec.Image,
+					TTY:        true,
+					Stdin:      true, // 保持标准输入打开，避免默认的 shell 立即退出
+					WorkingDir: "/workspace",
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    *resource.NewMilliQuantity(spec.Resources.NanoCPUs/1e6, resource.DecimalSI),
+							corev1.ResourceMemory: *resource.NewQuantity(spec.Resources.MemoryBytes, resource.BinarySI),
+						},
+						Requests: requests,
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "workspace", MountPath: "/workspace", SubPath: volume.subPath},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{Name: "workspace", VolumeSource: volume.source},
+			},
+		},
+	}
+
+	created, err := r.clientset.CoreV1().Pods(r.conf.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	return created.Name, nil
+}
+
+// kubeVolume 工作区卷
+type kubeVolume struct {
+	source  corev1.VolumeSource
+	subPath string
+}
+
+// workspaceVolume 计算挂载工作区目录的卷
+// - 配置了 PVC 时挂载 PVC 中与数据目录相对路径相同的子目录，否则使用 hostPath
+func (r *KubernetesRuntime) workspaceVolume(workspaceDir string) (kubeVolume, error) {
+	if r.conf.WorkspaceClaim == "" {
+		hostPathType := corev1.HostPathDirectoryOrCreate
+		return kubeVolume{source: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: workspaceDir, Type: &hostPathType},
+		}}, nil
+	}
+
+	rel, err := filepath.Rel(r.dataDirectory, workspaceDir)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return kubeVolume{}, fmt.Errorf("workspace %s is outside of data directory %s", workspaceDir, r.dataDirectory)
+	}
+	return kubeVolume{
+		source: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: r.conf.WorkspaceClaim},
+		},
+		subPath: filepath.ToSlash(rel),
+	}, nil
+}
+
+// WaitRunning 等待 Pod 的容器进入运行状态
+// - Pod 失败或容器处于无法恢复的等待状态时返回 ErrStartFailed
+func (r *KubernetesRuntime) WaitRunning(ctx context.Context, id string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(taskPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pod, err := r.clientset.CoreV1().Pods(r.conf.Namespace).Get(ctx, id, metav1.GetOptions{})
+		if err != nil && ctx.Err() == nil {
+			return notFoundKube(err)
+		}
+		if err == nil {
+			state := podState(pod)
+			switch {
+			case state.Running:
+				return nil
+			case pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded:
+				return fmt.Errorf("%w: pod %s: %s", ErrStartFailed, pod.Status.Phase, state.Message)
+			}
+			if waiting := containerWaiting(pod); waiting != nil && slices.Contains(kubeStartFailures, waiting.Reason) {
+				return fmt.Errorf("%w: container %s: %s", ErrStartFailed, waiting.Reason, waiting.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: timed out after %v", ErrStartFailed, timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Remove 删除 Pod，并清理该 Pod 的 Exec 记录
+func (r *KubernetesRuntime) Remove(ctx context.Context, id string) error {
+	err := notFoundKube(r.clientset.CoreV1().Pods(r.conf.Namespace).Delete(ctx, id, metav1.DeleteOptions{}))
+	if err == nil || errors.Is(err, ErrNotFound) {
+		r.mu.Lock()
+		maps.DeleteFunc(r.execs, func(_ string, e *kubeExec) bool { return e.pod == id })
+		r.mu.Unlock()
+	}
+	return err
+}
+
+// Inspect 查询 Pod 的状态
+func (r *KubernetesRuntime) Inspect(ctx context.Context, id string) (*State, error) {
+	pod, err := r.clientset.CoreV1().Pods(r.conf.Namespace).Get(ctx, id, metav1.GetOptions{})
+	if err != nil {
+		return nil, notFoundKube(err)
+	}
+	state := podState(pod)
+	return &state, nil
+}
+
+// List 列出由本后端创建、名称以 `prefix` 开头的 Pod
+func (r *KubernetesRuntime) List(ctx context.Context, prefix string) ([]Instance, error) {
+	pods, err := r.clientset.CoreV1().Pods(r.conf.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{kubeLabelManagedBy: kubeManagedBy}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]Instance, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		if strings.HasPrefix(pod.Name, prefix) {
+			instances = append(instances, Instance{ID: pod.Name, Name: pod.Name})
+		}
+	}
+	return instances, nil
+}
+
+// EnsureNetwork 确保网络对应的 NetworkPolicy 存在
+// - 内部网络只允许访问同一网络中的 Pod，其余网络允许所有出站访问
+// - 入站流量只允许来自同一网络中的 Pod
+func (r *KubernetesRuntime) EnsureNetwork(ctx context.Context, name string, internal bool) error {
+	sameNetwork := []networkingv1.NetworkPolicyPeer{{
+		PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{kubeLabelNetwork: name}},
+	}}
+	egress := []networkingv1.NetworkPolicyEgressRule{{}} // 空规则允许所有出站访问
+	if internal {
+		egress = []networkingv1.NetworkPolicyEgressRule{{To: sameNetwork}}
+	}
+	return r.ensurePolicy(ctx, name, &networkingv1.NetworkPolicySpec{
+		Ingress: []networkingv1.NetworkPolicyIngressRule{{From: sameNetwork}},
+		Egress:  egress,
+	})
+}
+
+// ensurePolicy 确保选择网络 `name` 中所有 Pod 的 NetworkPolicy 存在
+// - `rules`：允许的流量，为 nil 时拒绝所有出入站流量
+// - 已存在同名策略时不做修改
+func (r *KubernetesRuntime) ensurePolicy(ctx context.Context, name string, rules *networkingv1.NetworkPolicySpec) error {
+	policyName := "liteide-network-" + name
+	_, err := r.clientset.NetworkingV1().NetworkPolicies(r.conf.Namespace).Get(ctx, policyName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	spec := networkingv1.NetworkPolicySpec{}
+	if rules != nil {
+		spec = *rules
+	}
+	spec.PodSelector = metav1.LabelSelector{MatchLabels: map[string]string{kubeLabelNetwork: name}}
+	spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}
+
+	_, err = r.clientset.NetworkingV1().NetworkPolicies(r.conf.Namespace).Create(ctx, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      policyName,
+			Namespace: r.conf.Namespace,
+			Labels:    map[string]string{kubeLabelManagedBy: kubeManagedBy},
+		},
+		Spec: spec,
+	}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return nil // 并发请求已创建
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("network policy created: %s", policyName)
+	return nil
+}
+
+// PullImage 在所有节点上拉取镜像
+// - 通过一次性的 DaemonSet 实现：每个节点上的 Pod 以该镜像运行 `true` 作为初始化容器，全部就绪即拉取完成
+// - 因此镜像需要提供 `true` 命令（常见的语言基础镜像均满足）
+func (r *KubernetesRuntime) PullImage(ctx context.Context, name string, reference string, timeout time.Duration) error {
+	daemonSets := r.clientset.AppsV1().DaemonSets(r.conf.Namespace)
+
+	// 删除上一次异常退出时遗留的同名 DaemonSet
+	if err := daemonSets.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	selector := map[string]string{kubeLabelManagedBy: kubeManagedBy, "liteide.io/pull": name}
+	_, err := daemonSets.Create(ctx, &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: r.conf.Namespace, Labels: selector},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: selector},
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "pull", Image: reference, Command: []string{"true"}}},
+					Containers:     []corev1.Container{{Name: "pause", Image: r.conf.PauseImage}},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	// 无论结果如何都删除一次性 DaemonSet
+	defer func() {
+		if err := daemonSets.Delete(context.WithoutCancel(ctx), name, metav1.DeleteOptions{}); err != nil {
+			log.Warnf("failed to remove pull daemonset %s: %v", name, err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(taskPollInterval)
+	defer ticker.Stop()
+
+	for {
+		daemonSet, err := daemonSets.Get(ctx, name, metav1.GetOptions{})
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if err == nil {
+			status := daemonSet.Status
+			if status.ObservedGeneration >= daemonSet.Generation && status.DesiredNumberScheduled > 0 &&
+				status.NumberReady == status.DesiredNumberScheduled {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %v", timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExecCreate 记录 Exec 配置，Pod 不存在或未运行时返回 ErrNotFound
+func (r *KubernetesRuntime) ExecCreate(ctx context.Context, id string, config ExecConfig) (string, error) {
+	state, err := r.Inspect(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if !state.Running {
+		return "", ErrNotFound
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reapExecs()
+	r.nextId++
+	execId := id + "-exec-" + strconv.Itoa(r.nextId)
+	r.execs[execId] = &kubeExec{
+		pod:        id,
+		config:     config,
+		sizes:      make(chan remotecommand.TerminalSize, 1),
+		updateTime: time.Now(),
+	}
+	return execId, nil
+}
+
+// reapExecs 清理超过保留时间的已结束或未启动的 Exec 记录（调用方持有 r.mu）
+// - 运行中的 Exec 不会被清理，命令结束后再开始计时
+func (r *KubernetesRuntime) reapExecs() {
+	expired := time.Now().Add(-r.execRetention)
+	maps.DeleteFunc(r.execs, func(_ string, e *kubeExec) bool {
+		return (e.done || !e.started) && e.updateTime.Before(expired)
+	})
+}
+
+// ExecAttach 通过 exec 子资源启动命令，并通过内存管道连接其输入输出
+// - 非 TTY 模式下按 Docker 的多路复用格式输出，与其他运行时一致
+func (r *KubernetesRuntime) ExecAttach(ctx context.Context, execId string, tty bool) (types.HijackedResponse, error) {
+	r.mu.Lock()
+	e, ok := r.execs[execId]
+	if ok && !e.started {
+		e.started = true
+	} else if ok {
+		ok = false
+	}
+	r.mu.Unlock()
+	if !ok {
+		return types.HijackedResponse{}, ErrNotFound
+	}
+
+	server, client := net.Pipe()
+	streams := remotecommand.StreamOptions{Tty: tty}
+	if tty {
+		streams.Stdout = server
+		streams.TerminalSizeQueue = e
+	} else {
+		streams.Stdout = stdcopy.NewStdWriter(server, stdcopy.Stdout)
+		streams.Stderr = stdcopy.NewStdWriter(server, stdcopy.Stderr)
+	}
+	if e.config.AttachStdin {
+		streams.Stdin = server
+	}
+
+	options := &corev1.PodExecOptions{
+		Container: kubeContainerName,
+		Command:   execCommand(e.config),
+		Stdin:     e.config.AttachStdin,
+		Stdout:    true,
+		Stderr:    !tty, // TTY 模式下标准错误合并到标准输出
+		TTY:       tty,
+	}
+
+	// 命令退出后记录退出码并关闭连接
+	go func() {
+		err := r.executor.Stream(ctx, r.conf.Namespace, e.pod, options, streams)
+		code := 0
+		var exitErr utilexec.ExitError
+		if errors.As(err, &exitErr) {
+			code = exitErr.ExitStatus()
+		} else if err != nil {
+			log.Warnf("exec %s failed: %v", execId, err)
+			code = -1
+		}
+
+		r.mu.Lock()
+		e.done = true
+		e.exitCode = code
+		e.updateTime = time.Now()
+		close(e.sizes)
+		r.mu.Unlock()
+		_ = server.Close()
+	}()
+
+	return types.HijackedResponse{Conn: client, Reader: bufio.NewReader(client)}, nil
+}
+
+// ExecInspect 查询 Exec 进程的状态
+func (r *KubernetesRuntime) ExecInspect(_ context.Context, execId string) (*ExecStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.execs[execId]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &ExecStatus{Running: e.started && !e.done, ExitCode: e.exitCode}, nil
+}
+
+// ExecResize 调整 Exec 进程的 TTY 尺寸
+// - 只保留最近一次尺寸，尚未发送的旧尺寸会被丢弃
+func (r *KubernetesRuntime) ExecResize(_ context.Context, execId string, cols uint, rows uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.execs[execId]
+	if !ok {
+		return ErrNotFound
+	}
+	if e.done {
+		return nil
+	}
+	size := remotecommand.TerminalSize{Width: uint16(cols), Height: uint16(rows)}
+	select {
+	case <-e.sizes:
+	default:
+	}
+	e.sizes <- size
+	return nil
+}
+
+// execCommand 返回 exec 子资源执行的命令
+// - exec 子资源不支持指定工作目录，需要时通过 shell 切换
+func execCommand(config ExecConfig) []string {
+	if config.WorkingDir == "" || config.WorkingDir == "/workspace" {
+		return config.Cmd // Pod 的默认工作目录即 /workspace
+	}
+	return append([]string{"sh", "-c", `cd "$0" && exec "$@"`, config.WorkingDir}, config.Cmd...)
+}
+
+// podState 将 Pod 状态转换为实例状态
+func podState(pod *corev1.Pod) State {
+	state := State{
+		State:   strings.ToLower(string(pod.Status.Phase)),
+		Message: pod.Status.Message,
+		NodeID:  pod.Spec.NodeName,
+	}
+	if pod.Status.StartTime != nil {
+		state.UpdateTime = pod.Status.StartTime.Time
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.LastTransitionTime.After(state.UpdateTime) {
+			state.UpdateTime = condition.LastTransitionTime.Time
+		}
+		// 无法调度时给出调度器的原因
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && state.Message == "" {
+			state.Message = condition.Message
+		}
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != kubeContainerName {
+			continue
+		}
+		switch {
+		case status.State.Running != nil:
+			state.Running = pod.Status.Phase == corev1.PodRunning
+		case status.State.Waiting != nil:
+			state.Message = strings.TrimSuffix(status.State.Waiting.Reason+": "+status.State.Waiting.Message, ": ")
+		case status.State.Terminated != nil:
+			terminated := status.State.Terminated
+			state.Message = fmt.Sprintf("%s: exit code %d", terminated.Reason, terminated.ExitCode)
+		}
+	}
+	return state
+}
+
+// containerWaiting 返回工作区容器的等待状态，容器不处于等待状态时返回 nil
+func containerWaiting(pod *corev1.Pod) *corev1.ContainerStateWaiting {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == kubeContainerName {
+			return status.State.Waiting
+		}
+	}
+	return nil
+}
+
+// notFoundKube 将 Kubernetes 的 404 错误转换为 ErrNotFound
+func notFoundKube(err error) error {
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
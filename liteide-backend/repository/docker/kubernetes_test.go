@@ -0,0 +1,356 @@
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/docker/docker/pkg/stdcopy"
+	"io"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+	"liteide-backend/config"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeExecutor 记录 exec 请求，向标准输出写入固定内容并以指定退出码结束
+type fakeExecutor struct {
+	output  string
+	code    int
+	options *corev1.PodExecOptions
+}
+
+func (e *fakeExecutor) Stream(_ context.Context, _ string, _ string, options *corev1.PodExecOptions, streams remotecommand.StreamOptions) error {
+	e.options = options
+	if _, err := streams.Stdout.Write([]byte(e.output)); err != nil {
+		return err
+	}
+	if e.code != 0 {
+		return utilexec.CodeExitError{Err: errors.New("command terminated"), Code: e.code}
+	}
+	return nil
+}
+
+// newKubeRuntime 使用 fake clientset 创建 Kubernetes 运行时
+func newKubeRuntime(conf config.KubernetesConfig, executor PodExecutor) (*KubernetesRuntime, *fake.Clientset) {
+	clientset := fake.NewClientset()
+	if conf.Namespace == "" {
+		conf.Namespace = "liteide"
+	}
+	return NewKubernetesRuntime(clientset, executor, conf, "/data"), clientset
+}
+
+// testSpec 测试使用的实例配置
+func testSpec(name string) Spec {
+	return Spec{
+		Name:         name,
+		Image:        "gcc:13",
+		WorkspaceDir: "/data/workspace/1",
+		Network:      NetworkNone,
+		Resources: Resources{
+			NanoCPUs:           1e9,
+			MemoryBytes:        512 << 20,
+			PidsLimit:          64,
+			NanoCPUReservation: 1e8,
+			MemoryReservation:  64 << 20,
+		},
+	}
+}
+
+// setPodStatus 更新 Pod 的状态
+func setPodStatus(t *testing.T, clientset *fake.Clientset, name string, status corev1.PodStatus) {
+	t.Helper()
+	ctx := context.Background()
+	pod, err := clientset.CoreV1().Pods("liteide").Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get pod: %v", err)
+	}
+	pod.Status = status
+	if _, err := clientset.CoreV1().Pods("liteide").UpdateStatus(ctx, pod, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("update pod status: %v", err)
+	}
+}
+
+// runningStatus 工作区容器运行中的 Pod 状态
+func runningStatus() corev1.PodStatus {
+	return corev1.PodStatus{
+		Phase: corev1.PodRunning,
+		ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  kubeContainerName,
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		}},
+	}
+}
+
+func TestKubernetesCreate(t *testing.T) {
+	tests := []struct {
+		name         string
+		claim        string
+		workspaceDir string
+		wantErr      bool
+		wantSubPath  string
+	}{
+		{name: "host path", workspaceDir: "/data/workspace/1"},
+		{name: "claim", claim: "liteide-data", workspaceDir: "/data/workspace/1", wantSubPath: "workspace/1"},
+		{name: "claim outside data directory", claim: "liteide-data", workspaceDir: "/tmp/workspace/1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runtime, clientset := newKubeRuntime(config.KubernetesConfig{WorkspaceClaim: tt.claim}, nil)
+			spec := testSpec("liteide-pod-1")
+			spec.WorkspaceDir = tt.workspaceDir
+
+			id, err := runtime.Create(context.Background(), spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			pod, err := clientset.CoreV1().Pods("liteide").Get(context.Background(), id, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("get pod: %v", err)
+			}
+			container := pod.Spec.Containers[0]
+			if container.Image != "gcc:13" || !container.TTY || container.WorkingDir != "/workspace" {
+				t.Errorf("unexpected container %+v", container)
+			}
+			if cpu := container.Resources.Limits.Cpu().MilliValue(); cpu != 1000 {
+				t.Errorf("cpu limit = %dm, want 1000m", cpu)
+			}
+			if memory := container.Resources.Requests.Memory().Value(); memory != 64<<20 {
+				t.Errorf("memory request = %d, want %d", memory, 64<<20)
+			}
+			if subPath := container.VolumeMounts[0].SubPath; subPath != tt.wantSubPath {
+				t.Errorf("subPath = %q, want %q", subPath, tt.wantSubPath)
+			}
+			volume := pod.Spec.Volumes[0].VolumeSource
+			if tt.claim == "" && (volume.HostPath == nil || volume.HostPath.Path != tt.workspaceDir) {
+				t.Errorf("volume = %+v, want host path %s", volume, tt.workspaceDir)
+			}
+			if tt.claim != "" && (volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != tt.claim) {
+				t.Errorf("volume = %+v, want claim %s", volume, tt.claim)
+			}
+
+			// 不接入网络的 Pod 由拒绝所有流量的策略隔离
+			policy, err := clientset.NetworkingV1().NetworkPolicies("liteide").Get(context.Background(), "liteide-network-none", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("get network policy: %v", err)
+			}
+			if len(policy.Spec.Ingress) != 0 || len(policy.Spec.Egress) != 0 || len(policy.Spec.PolicyTypes) != 2 {
+				t.Errorf("network policy should deny all traffic, got %+v", policy.Spec)
+			}
+		})
+	}
+}
+
+func TestKubernetesWaitRunning(t *testing.T) {
+	tests := []struct {
+		name   string
+		status *corev1.PodStatus // 为 nil 时不更新状态
+		id     string
+		want   error
+	}{
+		{name: "running", status: func() *corev1.PodStatus { s := runningStatus(); return &s }()},
+		{name: "image pull failure", status: &corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  kubeContainerName,
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			}},
+		}, want: ErrStartFailed},
+		{name: "pod failed", status: &corev1.PodStatus{Phase: corev1.PodFailed, Message: "evicted"}, want: ErrStartFailed},
+		{name: "timed out", want: ErrStartFailed},
+		{name: "missing", id: "liteide-pod-2", want: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runtime, clientset := newKubeRuntime(config.KubernetesConfig{}, nil)
+			id, err := runtime.Create(context.Background(), testSpec("liteide-pod-1"))
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if tt.status != nil {
+				setPodStatus(t, clientset, id, *tt.status)
+			}
+			if tt.id != "" {
+				id = tt.id
+			}
+
+			if err := runtime.WaitRunning(context.Background(), id, 100*time.Millisecond); !errors.Is(err, tt.want) {
+				t.Errorf("WaitRunning() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestKubernetesListRemove(t *testing.T) {
+	runtime, clientset := newKubeRuntime(config.KubernetesConfig{}, nil)
+	ctx := context.Background()
+	for _, name := range []string{"liteide-pod-1", "liteide-pod-2", "other-1"} {
+		if _, err := runtime.Create(ctx, testSpec(name)); err != nil {
+			t.Fatalf("Create(%s) error = %v", name, err)
+		}
+	}
+	// 不是由本后端创建的 Pod 不会被列出
+	_, err := clientset.CoreV1().Pods("liteide").Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "liteide-pod-3", Namespace: "liteide"},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("create pod: %v", err)
+	}
+
+	names := func() []string {
+		instances, err := runtime.List(ctx, "liteide-pod-")
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		var names []string
+		for _, instance := range instances {
+			names = append(names, instance.Name)
+		}
+		slices.Sort(names)
+		return names
+	}
+	if got := names(); !slices.Equal(got, []string{"liteide-pod-1", "liteide-pod-2"}) {
+		t.Errorf("List() = %v", got)
+	}
+
+	if err := runtime.Remove(ctx, "liteide-pod-1"); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if err := runtime.Remove(ctx, "liteide-pod-1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("second Remove() error = %v, want %v", err, ErrNotFound)
+	}
+	if got := names(); !slices.Equal(got, []string{"liteide-pod-2"}) {
+		t.Errorf("List() after Remove() = %v", got)
+	}
+}
+
+func TestKubernetesExec(t *testing.T) {
+	tests := []struct {
+		name       string
+		running    bool
+		workingDir string
+		code       int
+		wantErr    error
+		wantCmd    []string
+	}{
+		{name: "success", running: true, workingDir: "/workspace", wantCmd: []string{"echo", "hello"}},
+		{name: "exit code", running: true, code: 3, wantCmd: []string{"echo", "hello"}},
+		{name: "working directory", running: true, workingDir: "/workspace/src",
+			wantCmd: []string{"sh", "-c", `cd "$0" && exec "$@"`, "/workspace/src", "echo", "hello"}},
+		{name: "pod not running", wantErr: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor := &fakeExecutor{output: "hello\n", code: tt.code}
+			runtime, clientset := newKubeRuntime(config.KubernetesConfig{}, executor)
+			ctx := context.Background()
+			id, err := runtime.Create(ctx, testSpec("liteide-pod-1"))
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if tt.running {
+				setPodStatus(t, clientset, id, runningStatus())
+			}
+
+			execId, err := runtime.ExecCreate(ctx, id, ExecConfig{Cmd: []string{"echo", "hello"}, WorkingDir: tt.workingDir})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ExecCreate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			conn, err := runtime.ExecAttach(ctx, execId, false)
+			if err != nil {
+				t.Fatalf("ExecAttach() error = %v", err)
+			}
+			defer conn.Close()
+			var stdout, stderr bytes.Buffer
+			if _, err := stdcopy.StdCopy(&stdout, &stderr, conn.Reader); err != nil {
+				t.Fatalf("StdCopy() error = %v", err)
+			}
+			if stdout.String() != "hello\n" {
+				t.Errorf("stdout = %q, want %q", stdout.String(), "hello\n")
+			}
+			if !slices.Equal(executor.options.Command, tt.wantCmd) || executor.options.TTY {
+				t.Errorf("exec options = %+v, want command %v", executor.options, tt.wantCmd)
+			}
+
+			status, err := runtime.ExecInspect(ctx, execId)
+			if err != nil {
+				t.Fatalf("ExecInspect() error = %v", err)
+			}
+			if status.Running || status.ExitCode != tt.code {
+				t.Errorf("ExecInspect() = %+v, want exit code %d", *status, tt.code)
+			}
+			if _, err := runtime.ExecAttach(ctx, execId, false); !errors.Is(err, ErrNotFound) {
+				t.Errorf("second ExecAttach() error = %v, want %v", err, ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestKubernetesExecCleanup(t *testing.T) {
+	runtime, clientset := newKubeRuntime(config.KubernetesConfig{}, &fakeExecutor{output: "hello\n"})
+	runtime.execRetention = 50 * time.Millisecond
+	ctx := context.Background()
+	for _, name := range []string{"liteide-pod-1", "liteide-pod-2"} {
+		if _, err := runtime.Create(ctx, testSpec(name)); err != nil {
+			t.Fatalf("Create(%s) error = %v", name, err)
+		}
+		setPodStatus(t, clientset, name, runningStatus())
+	}
+	execCount := func() int {
+		runtime.mu.Lock()
+		defer runtime.mu.Unlock()
+		return len(runtime.execs)
+	}
+
+	// 运行结束的 Exec 与从未启动的 Exec
+	finished, err := runtime.ExecCreate(ctx, "liteide-pod-1", ExecConfig{Cmd: []string{"echo", "hello"}})
+	if err != nil {
+		t.Fatalf("ExecCreate() error = %v", err)
+	}
+	conn, err := runtime.ExecAttach(ctx, finished, false)
+	if err != nil {
+		t.Fatalf("ExecAttach() error = %v", err)
+	}
+	_, _ = io.Copy(io.Discard, conn.Reader)
+	conn.Close()
+	if _, err := runtime.ExecCreate(ctx, "liteide-pod-1", ExecConfig{Cmd: []string{"true"}}); err != nil {
+		t.Fatalf("ExecCreate() error = %v", err)
+	}
+
+	// 保留时间内仍可查询退出码
+	if _, err := runtime.ExecInspect(ctx, finished); err != nil {
+		t.Fatalf("ExecInspect() error = %v", err)
+	}
+
+	// 超过保留时间后，创建新 Exec 时清理旧记录
+	time.Sleep(100 * time.Millisecond)
+	if _, err := runtime.ExecCreate(ctx, "liteide-pod-2", ExecConfig{Cmd: []string{"true"}}); err != nil {
+		t.Fatalf("ExecCreate() error = %v", err)
+	}
+	if _, err := runtime.ExecInspect(ctx, finished); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ExecInspect() after retention error = %v, want %v", err, ErrNotFound)
+	}
+	if got := execCount(); got != 1 {
+		t.Errorf("exec records = %d, want 1", got)
+	}
+
+	// 删除 Pod 时清理该 Pod 的全部 Exec 记录
+	if err := runtime.Remove(ctx, "liteide-pod-2"); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if got := execCount(); got != 0 {
+		t.Errorf("exec records after Remove() = %d, want 0", got)
+	}
+}
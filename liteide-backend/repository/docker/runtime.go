@@ -0,0 +1,220 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/api/types" // 引入 Docker API 类型，Exec 连接沿用 HijackedResponse
+	"github.com/docker/docker/client"    // 引入 Docker 客户端库
+	"github.com/gofiber/fiber/v2/log"    // 引入 Fiber 的日志库
+	"liteide-backend/config"             // 引入配置管理包，用于选择容器运行时
+	"slices"
+	"time"
+)
+
+// 容器运行时返回的错误类型，服务层据此映射业务错误
+var (
+	ErrNotFound    = errors.New("instance not found")       // 实例不存在，或没有正在运行的容器
+	ErrStartFailed = errors.New("instance failed to start") // 实例未能进入运行状态
+)
+
+// 可选的容器运行时
+const (
+	RuntimeSwarm      = "swarm"      // Docker Swarm：每个容器对应一个单副本服务
+	RuntimeDocker     = "docker"     // 单机 Docker：直接创建容器，无需初始化 Swarm
+	RuntimeKubernetes = "kubernetes" // Kubernetes：每个容器对应一个 Pod
+	RuntimeFake       = "fake"       // 内存运行时：不创建容器，Exec 在本机执行，仅用于测试与离线开发
+)
+
+// NetworkNone Docker 预定义的无网络
+const NetworkNone = "none"
+
+// Resources 容器的资源限制与预留
+type Resources struct {
+	NanoCPUs           int64 // CPU 上限（单位：1e-9 核）
+	MemoryBytes        int64 // 内存上限（字节）
+	PidsLimit          int64 // 进程数上限
+	NanoCPUReservation int64 // CPU 预留（单位：1e-9 核）
+	MemoryReservation  int64 // 内存预留（字节）
+}
+
+// Spec 创建容器实例的配置
+type Spec struct {
+	Name         string    // 实例名称，用于对账时识别由本服务创建的实例
+	Image        string    // 镜像引用
+	WorkspaceDir string    // 宿主机上的工作区目录，绑定挂载到容器的 /workspace
+	Resources    Resources // 资源限制与预留
+	Network      string    // 接入的网络名称，NetworkNone 表示不接入任何网络
+}
+
+// Instance 运行时中的容器实例
+type Instance struct {
+	ID   string // 实例 ID（Swarm 服务 ID 或 Docker 容器 ID）
+	Name string // 实例名称
+}
+
+// State 容器实例的实时状态
+type State struct {
+	State      string    // 原始状态，例如 Swarm 任务的 running、failed 或 Docker 容器的 running、exited
+	Running    bool      // 是否正在运行
+	Message    string    // 状态说明或错误信息
+	NodeID     string    // 实例所在的节点 ID，单机 Docker 为空
+	UpdateTime time.Time // 状态更新时间
+}
+
+// terminalStates 实例不会再自行恢复运行的状态
+// - Swarm 任务：failed、complete、shutdown、rejected
+// - Docker 容器：exited、dead
+// - Kubernetes Pod：failed、succeeded
+var terminalStates = []string{"exited", "dead", "failed", "complete", "shutdown", "rejected", "succeeded"}
+
+// Terminated 实例是否已终止
+// - 准备中、启动中、重启中等过渡状态返回 false，运行时会自行重启实例
+func (s State) Terminated() bool {
+	return !s.Running && slices.Contains(terminalStates, s.State)
+}
+
+// ExecConfig 在容器内执行命令的配置
+type ExecConfig struct {
+	Cmd         []string // 命令及参数
+	WorkingDir  string   // 工作目录，为空时使用镜像默认值
+	Tty         bool     // 是否分配 TTY，非 TTY 模式下输出为多路复用流
+	AttachStdin bool     // 是否连接标准输入
+}
+
+// ExecStatus Exec 进程的状态
+type ExecStatus struct {
+	Running  bool // 是否仍在运行
+	ExitCode int  // 退出码，仅在进程退出后有效
+}
+
+// ContainerRuntime 容器运行时
+// - 服务层只通过该接口管理容器，不直接依赖 Swarm 或 Docker API
+type ContainerRuntime interface {
+	// Create 创建并启动容器实例，返回实例 ID（不等待实例进入运行状态）
+	Create(ctx context.Context, spec Spec) (string, error)
+	// WaitRunning 等待实例进入运行状态，失败或超时时返回 ErrStartFailed
+	WaitRunning(ctx context.Context, id string, timeout time.Duration) error
+	// Remove 删除实例，实例不存在时返回 ErrNotFound
+	Remove(ctx context.Context, id string) error
+	// Inspect 查询实例的实时状态，实例尚无状态（例如任务未调度）时返回 nil
+	Inspect(ctx context.Context, id string) (*State, error)
+	// List 列出名称以 `prefix` 开头的实例
+	List(ctx context.Context, prefix string) ([]Instance, error)
+	// EnsureNetwork 确保网络存在，`internal` 为 true 时禁止出站访问
+	EnsureNetwork(ctx context.Context, name string, internal bool) error
+	// PullImage 将镜像拉取到所有节点，`name` 为拉取任务的名称
+	PullImage(ctx context.Context, name string, reference string, timeout time.Duration) error
+
+	// ExecCreate 在实例的容器内创建 Exec 进程，没有正在运行的容器时返回 ErrNotFound
+	ExecCreate(ctx context.Context, id string, config ExecConfig) (string, error)
+	// ExecAttach 启动 Exec 进程并连接其输入输出
+	ExecAttach(ctx context.Context, execId string, tty bool) (types.HijackedResponse, error)
+	// ExecInspect 查询 Exec 进程的状态
+	ExecInspect(ctx context.Context, execId string) (*ExecStatus, error)
+	// ExecResize 调整 Exec 进程的 TTY 尺寸
+	ExecResize(ctx context.Context, execId string, cols uint, rows uint) error
+}
+
+// NewRuntime 根据配置创建容器运行时
+// - `conf`：应用配置，ContainerRuntime 为 RuntimeSwarm、RuntimeDocker、RuntimeKubernetes 或 RuntimeFake
+// - fake 运行时在本机直接执行用户命令，未设置 AllowFakeRuntime 时拒绝创建
+func NewRuntime(conf config.AppConfig) (ContainerRuntime, error) {
+	switch conf.ContainerRuntime {
+	case RuntimeSwarm:
+		return NewSwarmRuntime(InitDocker()), nil
+	case RuntimeDocker:
+		return NewDockerRuntime(InitDocker()), nil
+	case RuntimeKubernetes:
+		restConfig, clientset, err := InitKubernetes(conf.KubernetesConfig.Kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+		return NewKubernetesRuntime(clientset, NewSPDYExecutor(restConfig, clientset), conf.KubernetesConfig, conf.DataDirectory), nil
+	case RuntimeFake:
+		if !conf.AllowFakeRuntime {
+			return nil, errors.New("fake runtime executes user commands on the host without any sandbox, set ALLOW_FAKE_RUNTIME=true to use it for development")
+		}
+		log.Warn("**************************************************************************")
+		log.Warn("* fake container runtime enabled: user commands run directly on the host *")
+		log.Warn("* without any sandbox. NEVER use it in production.                      *")
+		log.Warn("**************************************************************************")
+		return NewFakeRuntime(), nil
+	default:
+		return nil, fmt.Errorf("unknown container runtime: %s", conf.ContainerRuntime)
+	}
+}
+
+// execClient Swarm 与单机 Docker 共用的 Exec 实现（两者都在本机 Docker 守护进程上执行）
+type execClient struct {
+	client *client.Client
+}
+
+// ExecAttach 启动 Exec 进程并连接其输入输出
+func (e execClient) ExecAttach(ctx context.Context, execId string, tty bool) (types.HijackedResponse, error) {
+	return e.client.ContainerExecAttach(ctx, execId, types.ExecStartCheck{Detach: false, Tty: tty})
+}
+
+// ExecInspect 查询 Exec 进程的状态
+func (e execClient) ExecInspect(ctx context.Context, execId string) (*ExecStatus, error) {
+	inspect, err := e.client.ContainerExecInspect(ctx, execId)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecStatus{Running: inspect.Running, ExitCode: inspect.ExitCode}, nil
+}
+
+// ExecResize 调整 Exec 进程的 TTY 尺寸
+func (e execClient) ExecResize(ctx context.Context, execId string, cols uint, rows uint) error {
+	return e.client.ContainerExecResize(ctx, execId, types.ResizeOptions{Width: cols, Height: rows})
+}
+
+// execCreate 在指定的 Docker 容器内创建 Exec 进程
+func (e execClient) execCreate(ctx context.Context, containerId string, config ExecConfig) (string, error) {
+	response, err := e.client.ContainerExecCreate(ctx, containerId, types.ExecConfig{
+		AttachStdin:  config.AttachStdin,
+		AttachStdout: true,
+		AttachStderr: true,
+		Tty:          config.Tty,
+		WorkingDir:   config.WorkingDir,
+		Cmd:          config.Cmd,
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.ID, nil
+}
+
+// ensureNetwork 确保指定驱动的网络存在
+// - 已有同名网络但隔离属性不符时拒绝使用，避免意外放开出站访问
+func ensureNetwork(ctx context.Context, dockerClient *client.Client, name string, driver string, internal bool) (bool, error) {
+	network, err := dockerClient.NetworkInspect(ctx, name, types.NetworkInspectOptions{})
+	if err == nil {
+		if network.Internal != internal {
+			return false, fmt.Errorf("network %s has internal=%v, want %v", name, network.Internal, internal)
+		}
+		return false, nil
+	}
+	if !client.IsErrNotFound(err) {
+		return false, err
+	}
+
+	_, err = dockerClient.NetworkCreate(ctx, name, types.NetworkCreate{
+		CheckDuplicate: true,
+		Driver:         driver,
+		Internal:       internal,
+		Attachable:     driver == "overlay", // overlay 网络需要允许独立容器接入
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// notFound 将 Docker 的 404 错误转换为 ErrNotFound
+func notFound(err error) error {
+	if client.IsErrNotFound(err) {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
@@ -0,0 +1,22 @@
+package docker
+
+import (
+	"liteide-backend/config"
+	"testing"
+)
+
+func TestNewRuntimeFake(t *testing.T) {
+	conf := config.AppConfig{ContainerRuntime: RuntimeFake}
+	if _, err := NewRuntime(conf); err == nil {
+		t.Error("NewRuntime(fake) without ALLOW_FAKE_RUNTIME succeeded, want error")
+	}
+
+	conf.AllowFakeRuntime = true
+	runtime, err := NewRuntime(conf)
+	if err != nil {
+		t.Fatalf("NewRuntime(fake) error = %v", err)
+	}
+	if _, ok := runtime.(*FakeRuntime); !ok {
+		t.Errorf("NewRuntime(fake) = %T, want *FakeRuntime", runtime)
+	}
+}
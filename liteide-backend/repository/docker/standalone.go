@@ -0,0 +1,210 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/gofiber/fiber/v2/log"
+	"io"
+	"strings"
+	"time"
+)
+
+// DockerRuntime 基于单机 Docker 的容器运行时
+// - 每个容器对应一个 Docker 容器，实例 ID 为 Docker 容器 ID
+// - 不依赖 Swarm，适用于未初始化 Swarm 的开发环境
+type DockerRuntime struct {
+	execClient
+	client *client.Client
+}
+
+// NewDockerRuntime 创建基于单机 Docker 的容器运行时
+func NewDockerRuntime(dockerClient *client.Client) *DockerRuntime {
+	return &DockerRuntime{execClient: execClient{client: dockerClient}, client: dockerClient}
+}
+
+// Create 创建并启动 Docker 容器，本地没有镜像时先拉取
+func (r *DockerRuntime) Create(ctx context.Context, spec Spec) (string, error) {
+	config := &container.Config{
+		Image:      spec.Image,
+		Tty:        true,
+		OpenStdin:  true, // 保持标准输入打开，避免默认的 shell 立即退出
+		WorkingDir: "/workspace",
+	}
+	pidsLimit := spec.Resources.PidsLimit
+	hostConfig := &container.HostConfig{
+		NetworkMode:   container.NetworkMode(spec.Network),
+		RestartPolicy: container.RestartPolicy{Name: "unless-stopped"}, // 与 Swarm 一致，容器退出后自动重启
+		Mounts: []mount.Mount{
+			{
+				Type:   mount.TypeBind, // 绑定本地目录到容器
+				Source: spec.WorkspaceDir,
+				Target: "/workspace",
+			},
+		},
+		Resources: container.Resources{
+			NanoCPUs:          spec.Resources.NanoCPUs,
+			Memory:            spec.Resources.MemoryBytes,
+			MemoryReservation: spec.Resources.MemoryReservation, // 单机 Docker 不支持 CPU 预留
+			PidsLimit:         &pidsLimit,
+		},
+	}
+
+	response, err := r.client.ContainerCreate(ctx, config, hostConfig, nil, nil, spec.Name)
+	if client.IsErrNotFound(err) {
+		// 本地没有镜像，拉取后重试
+		if err := r.pull(ctx, spec.Image); err != nil {
+			return "", err
+		}
+		response, err = r.client.ContainerCreate(ctx, config, hostConfig, nil, nil, spec.Name)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.client.ContainerStart(ctx, response.ID, types.ContainerStartOptions{}); err != nil {
+		// 启动失败时删除已创建的容器，避免遗留同名容器
+		if err := r.client.ContainerRemove(context.WithoutCancel(ctx), response.ID, types.ContainerRemoveOptions{Force: true}); err != nil {
+			log.Errorf("failed to remove container %s: %v", response.ID, err)
+		}
+		return "", fmt.Errorf("%w: %w", ErrStartFailed, err)
+	}
+	return response.ID, nil
+}
+
+// WaitRunning 等待 Docker 容器进入 running 状态
+// - 容器已退出或超时时返回 ErrStartFailed
+func (r *DockerRuntime) WaitRunning(ctx context.Context, id string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(taskPollInterval)
+	defer ticker.Stop()
+
+	for {
+		state, err := r.Inspect(ctx, id)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if state != nil {
+			switch {
+			case state.Running:
+				return nil
+			case state.State == "exited" || state.State == "dead":
+				return fmt.Errorf("%w: container %s: %s", ErrStartFailed, state.State, state.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: timed out after %v", ErrStartFailed, timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Remove 强制删除 Docker 容器
+func (r *DockerRuntime) Remove(ctx context.Context, id string) error {
+	return notFound(r.client.ContainerRemove(ctx, id, types.ContainerRemoveOptions{Force: true}))
+}
+
+// Inspect 查询 Docker 容器的状态
+func (r *DockerRuntime) Inspect(ctx context.Context, id string) (*State, error) {
+	inspect, err := r.client.ContainerInspect(ctx, id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+	if inspect.State == nil {
+		return nil, nil
+	}
+
+	state := &State{
+		State:   inspect.State.Status,
+		Running: inspect.State.Running,
+		Message: inspect.State.Error,
+	}
+	if !state.Running && state.Message == "" && inspect.State.FinishedAt != "" {
+		state.Message = fmt.Sprintf("exit code %d", inspect.State.ExitCode)
+	}
+
+	// 运行中的容器取启动时间，已退出的容器取退出时间（未设置时 Docker 返回零值时间）
+	timestamp := inspect.State.StartedAt
+	if !state.Running {
+		timestamp = inspect.State.FinishedAt
+	}
+	if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil && !t.IsZero() {
+		state.UpdateTime = t
+	}
+	return state, nil
+}
+
+// List 列出名称以 `prefix` 开头的 Docker 容器（包括已退出的容器）
+func (r *DockerRuntime) List(ctx context.Context, prefix string) ([]Instance, error) {
+	// name 过滤为子串匹配，需要再按前缀筛选
+	containers, err := r.client.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("name", prefix)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]Instance, 0, len(containers))
+	for _, c := range containers {
+		for _, name := range c.Names {
+			name = strings.TrimPrefix(name, "/")
+			if strings.HasPrefix(name, prefix) {
+				instances = append(instances, Instance{ID: c.ID, Name: name})
+				break
+			}
+		}
+	}
+	return instances, nil
+}
+
+// EnsureNetwork 确保 bridge 网络存在
+func (r *DockerRuntime) EnsureNetwork(ctx context.Context, name string, internal bool) error {
+	created, err := ensureNetwork(ctx, r.client, name, "bridge", internal)
+	if err != nil {
+		return err
+	}
+	if created {
+		log.Infof("network created: %s (internal=%v)", name, internal)
+	}
+	return nil
+}
+
+// PullImage 在本机拉取镜像，单机 Docker 不需要拉取任务的名称
+func (r *DockerRuntime) PullImage(ctx context.Context, _ string, reference string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return r.pull(ctx, reference)
+}
+
+// ExecCreate 在 Docker 容器内创建 Exec 进程，容器未运行时返回 ErrNotFound
+func (r *DockerRuntime) ExecCreate(ctx context.Context, id string, config ExecConfig) (string, error) {
+	state, err := r.Inspect(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if state == nil || !state.Running {
+		return "", ErrNotFound
+	}
+	return r.execCreate(ctx, id, config)
+}
+
+// pull 拉取镜像并等待完成
+// - 拉取进度以 JSON 流返回，拉取失败的信息也包含在流中
+func (r *DockerRuntime) pull(ctx context.Context, reference string) error {
+	reader, err := r.client.ImagePull(ctx, reference, types.ImagePullOptions{})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return jsonmessage.DisplayJSONMessagesStream(reader, io.Discard, 0, false, nil)
+}
@@ -0,0 +1,296 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/docker/client"
+	"github.com/gofiber/fiber/v2/log"
+	"strings"
+	"time"
+)
+
+// taskPollInterval 轮询 Swarm 任务状态的间隔
+const taskPollInterval = 500 * time.Millisecond
+
+// SwarmRuntime 基于 Docker Swarm 的容器运行时
+// - 每个容器对应一个单副本服务，实例 ID 为 Swarm 服务 ID
+// - Exec 在服务的任务容器上执行，要求任务运行在后端所连接的节点上
+type SwarmRuntime struct {
+	execClient
+	client *client.Client
+}
+
+// NewSwarmRuntime 创建基于 Docker Swarm 的容器运行时
+func NewSwarmRuntime(dockerClient *client.Client) *SwarmRuntime {
+	return &SwarmRuntime{execClient: execClient{client: dockerClient}, client: dockerClient}
+}
+
+// Create 创建单副本的 Swarm 服务
+func (r *SwarmRuntime) Create(ctx context.Context, spec Spec) (string, error) {
+	service, err := r.client.ServiceCreate(ctx, swarmServiceSpec(spec), types.ServiceCreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	return service.ID, nil
+}
+
+// swarmServiceSpec 生成单副本 Swarm 服务的配置
+// - 网络为 NetworkNone 时省略网络配置：Swarm 服务不能以常规方式接入本地的 none 网络
+// - 服务不发布端口，也不接入任何 overlay 网络，其他容器无法访问任务容器
+func swarmServiceSpec(spec Spec) swarm.ServiceSpec {
+	// 设置 Swarm 任务副本数
+	replicas := uint64(1)
+
+	// 定义 Swarm 服务配置（相当于 Docker Service）
+	serviceSpec := swarm.ServiceSpec{
+		Annotations: swarm.Annotations{
+			Name: spec.Name,
+		},
+		TaskTemplate: swarm.TaskSpec{
+			Resources: &swarm.ResourceRequirements{
+				Limits: &swarm.Limit{
+					NanoCPUs:    spec.Resources.NanoCPUs,
+					MemoryBytes: spec.Resources.MemoryBytes,
+					Pids:        spec.Resources.PidsLimit,
+				},
+				Reservations: &swarm.Resources{
+					NanoCPUs:    spec.Resources.NanoCPUReservation,
+					MemoryBytes: spec.Resources.MemoryReservation,
+				},
+			},
+			ContainerSpec: &swarm.ContainerSpec{
+				Image: spec.Image,
+				TTY:   true,
+				Dir:   "/workspace",
+				Mounts: []mount.Mount{
+					{
+						Type:   mount.TypeBind, // 绑定本地目录到容器
+						Source: spec.WorkspaceDir,
+						Target: "/workspace",
+					},
+				},
+			},
+		},
+		Mode: swarm.ServiceMode{
+			Replicated: &swarm.ReplicatedService{
+				Replicas: &replicas,
+			},
+		},
+	}
+	if spec.Network != NetworkNone {
+		serviceSpec.TaskTemplate.Networks = []swarm.NetworkAttachmentConfig{{Target: spec.Network}}
+	}
+	return serviceSpec
+}
+
+// WaitRunning 等待 Swarm 服务的任务进入 running 状态
+// - 任务失败、被拒绝或超时时返回 ErrStartFailed，并附带任务的失败信息
+func (r *SwarmRuntime) WaitRunning(ctx context.Context, id string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(taskPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// 查询该服务下的所有任务
+		tasks, err := r.tasks(ctx, id)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			switch task.Status.State {
+			case swarm.TaskStateRunning:
+				return nil // 任务已启动
+			case swarm.TaskStateFailed, swarm.TaskStateRejected, swarm.TaskStateOrphaned:
+				// 任务无法启动，返回 Swarm 给出的失败原因
+				return fmt.Errorf("%w: task %s: %s", ErrStartFailed, task.Status.State, taskMessage(task))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: timed out after %v", ErrStartFailed, timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Remove 删除 Swarm 服务
+func (r *SwarmRuntime) Remove(ctx context.Context, id string) error {
+	return notFound(r.client.ServiceRemove(ctx, id))
+}
+
+// Inspect 查询 Swarm 服务最近更新的任务状态
+func (r *SwarmRuntime) Inspect(ctx context.Context, id string) (*State, error) {
+	tasks, err := r.tasks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *swarm.Task
+	for i := range tasks {
+		if latest == nil || tasks[i].Status.Timestamp.After(latest.Status.Timestamp) {
+			latest = &tasks[i]
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	return &State{
+		State:      string(latest.Status.State),
+		Running:    latest.Status.State == swarm.TaskStateRunning,
+		Message:    taskMessage(*latest),
+		NodeID:     latest.NodeID,
+		UpdateTime: latest.Status.Timestamp,
+	}, nil
+}
+
+// List 列出名称以 `prefix` 开头的 Swarm 服务
+func (r *SwarmRuntime) List(ctx context.Context, prefix string) ([]Instance, error) {
+	// name 过滤为前缀匹配
+	services, err := r.client.ServiceList(ctx, types.ServiceListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", prefix)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]Instance, 0, len(services))
+	for _, service := range services {
+		if strings.HasPrefix(service.Spec.Name, prefix) {
+			instances = append(instances, Instance{ID: service.ID, Name: service.Spec.Name})
+		}
+	}
+	return instances, nil
+}
+
+// EnsureNetwork 确保 overlay 网络存在
+func (r *SwarmRuntime) EnsureNetwork(ctx context.Context, name string, internal bool) error {
+	created, err := ensureNetwork(ctx, r.client, name, "overlay", internal)
+	if err != nil {
+		return err
+	}
+	if created {
+		log.Infof("network created: %s (internal=%v)", name, internal)
+	}
+	return nil
+}
+
+// PullImage 在所有 Swarm 节点上拉取镜像
+// - 通过 global-job 模式的一次性服务实现：Swarm 在每个节点上拉取镜像并运行 `true` 后退出
+// - 因此镜像需要提供 `true` 命令（常见的语言基础镜像均满足）
+func (r *SwarmRuntime) PullImage(ctx context.Context, name string, reference string, timeout time.Duration) error {
+	// 删除上一次异常退出时遗留的同名服务
+	if err := r.client.ServiceRemove(ctx, name); err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+
+	response, err := r.client.ServiceCreate(ctx, swarm.ServiceSpec{
+		Annotations: swarm.Annotations{
+			Name: name,
+		},
+		TaskTemplate: swarm.TaskSpec{
+			ContainerSpec: &swarm.ContainerSpec{
+				Image:   reference,
+				Command: []string{"true"},
+			},
+			RestartPolicy: &swarm.RestartPolicy{
+				Condition: swarm.RestartPolicyConditionNone, // 拉取失败时不重试，由下一次预拉取处理
+			},
+		},
+		Mode: swarm.ServiceMode{
+			GlobalJob: &swarm.GlobalJob{}, // 每个节点运行一次
+		},
+	}, types.ServiceCreateOptions{
+		QueryRegistry: true, // 由管理节点解析镜像摘要，保证各节点拉取同一版本
+	})
+	if err != nil {
+		return err
+	}
+
+	// 无论结果如何都删除一次性服务
+	defer func() {
+		if err := r.client.ServiceRemove(context.WithoutCancel(ctx), response.ID); err != nil {
+			log.Warnf("failed to remove pull service %s: %v", name, err)
+		}
+	}()
+
+	return r.waitJobComplete(ctx, response.ID, timeout)
+}
+
+// ExecCreate 在 Swarm 服务的任务容器内创建 Exec 进程
+func (r *SwarmRuntime) ExecCreate(ctx context.Context, id string, config ExecConfig) (string, error) {
+	// 查找该服务在本节点上运行的任务容器
+	instanceList, err := r.client.ContainerList(ctx, types.ContainerListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", "com.docker.swarm.service.id="+id)),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(instanceList) == 0 {
+		return "", ErrNotFound
+	}
+
+	// 使用找到的第一个容器实例
+	instance := instanceList[0]
+	log.Debugf("instance: %v", instance)
+	return r.execCreate(ctx, instance.ID, config)
+}
+
+// tasks 查询 Swarm 服务下的所有任务
+func (r *SwarmRuntime) tasks(ctx context.Context, serviceId string) ([]swarm.Task, error) {
+	return r.client.TaskList(ctx, types.TaskListOptions{
+		Filters: filters.NewArgs(filters.Arg("service", serviceId)),
+	})
+}
+
+// waitJobComplete 等待 Swarm 任务服务的所有任务结束
+// - 所有任务均为 complete 时返回 nil；任一任务失败或被拒绝时返回其失败信息
+func (r *SwarmRuntime) waitJobComplete(ctx context.Context, serviceId string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(taskPollInterval)
+	defer ticker.Stop()
+
+	for {
+		tasks, err := r.tasks(ctx, serviceId)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		complete := len(tasks) > 0
+		for _, task := range tasks {
+			switch task.Status.State {
+			case swarm.TaskStateComplete:
+			case swarm.TaskStateFailed, swarm.TaskStateRejected, swarm.TaskStateOrphaned:
+				return fmt.Errorf("node %s: task %s: %s", task.NodeID, task.Status.State, taskMessage(task))
+			default:
+				complete = false
+			}
+		}
+		if complete {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %v", timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// taskMessage 返回任务状态中最有用的说明信息
+func taskMessage(task swarm.Task) string {
+	if task.Status.Err != "" {
+		return task.Status.Err
+	}
+	return task.Status.Message
+}
@@ -0,0 +1,38 @@
+package docker
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSwarmServiceSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		network string
+		want    []string // 服务接入的网络
+	}{
+		{name: "none", network: NetworkNone},
+		{name: "overlay", network: "liteide-egress", want: []string{"liteide-egress"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := testSpec("liteide-pod-1")
+			spec.Network = tt.network
+			serviceSpec := swarmServiceSpec(spec)
+
+			var got []string
+			for _, network := range serviceSpec.TaskTemplate.Networks {
+				got = append(got, network.Target)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("networks = %v, want %v", got, tt.want)
+			}
+			if serviceSpec.EndpointSpec != nil && len(serviceSpec.EndpointSpec.Ports) > 0 {
+				t.Errorf("service publishes ports %v", serviceSpec.EndpointSpec.Ports)
+			}
+			if serviceSpec.Name != "liteide-pod-1" || *serviceSpec.Mode.Replicated.Replicas != 1 {
+				t.Errorf("unexpected service spec %+v", serviceSpec)
+			}
+		})
+	}
+}
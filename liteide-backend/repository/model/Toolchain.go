@@ -0,0 +1,146 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"liteide-backend/ent/property" // 引入 ent ORM 生成的 property 模型
+	"os"
+	"path"
+	"slices"
+	"strings"
+)
+
+// 命令模板中的占位符
+const (
+	entryPlaceholder  = "{entry}"  // 入口文件路径
+	outputPlaceholder = "{output}" // 本次运行独占的输出目录，用于存放编译产物，并发运行互不覆盖
+	classPlaceholder  = "{class}"  // 入口文件去掉目录与扩展名后的名称，例如 Java 的主类名
+)
+
+// Toolchain 描述一种编程语言的工具链
+type Toolchain struct {
+	Name           property.Language `json:"name"`            // 语言名称，例如 GO
+	DisplayName    string            `json:"display_name"`    // 显示名称，例如 Go
+	Image          string            `json:"image"`           // 默认容器镜像，例如 golang:1.23
+	Extensions     []string          `json:"extensions"`      // 源文件扩展名，例如 [".go"]
+	CompileCommand string            `json:"compile_command"` // 编译命令模板，解释型语言为空
+	RunCommand     string            `json:"run_command"`     // 运行命令模板
+	DefaultEntry   string            `json:"default_entry"`   // 默认入口文件，例如 main.go
+}
+
+// Validate 校验工具链定义
+func (t Toolchain) Validate() error {
+	if err := property.ValidateLanguage(string(t.Name)); err != nil {
+		return err
+	}
+	if t.DisplayName == "" || t.Image == "" || t.RunCommand == "" || t.DefaultEntry == "" {
+		return fmt.Errorf("language %s: display_name, image, run_command and default_entry are required", t.Name)
+	}
+	if len(t.Extensions) > 0 && !slices.Contains(t.Extensions, path.Ext(t.DefaultEntry)) {
+		return fmt.Errorf("language %s: default_entry %s does not match extensions %v", t.Name, t.DefaultEntry, t.Extensions)
+	}
+	return nil
+}
+
+// Command 生成编译并运行入口文件的 shell 命令
+// - `entry`：入口文件路径，替换 {entry}，其名称（不含目录与扩展名）替换 {class}
+// - `output`：本次运行的输出目录，替换 {output}，由调用方保证目录存在
+// - 所有占位符都替换为转义后的值
+func (t Toolchain) Command(entry string, output string) string {
+	replacer := strings.NewReplacer(
+		entryPlaceholder, shellQuote(entry),
+		outputPlaceholder, shellQuote(output),
+		classPlaceholder, shellQuote(strings.TrimSuffix(path.Base(entry), path.Ext(entry))),
+	)
+	run := replacer.Replace(t.RunCommand)
+	if t.CompileCommand == "" {
+		return run
+	}
+	return replacer.Replace(t.CompileCommand) + " && " + run
+}
+
+// Registry 语言工具链注册表
+type Registry struct {
+	toolchains []Toolchain // 按配置顺序保存的工具链
+}
+
+// NewRegistry 创建语言工具链注册表
+// - 工具链定义不合法或名称重复时返回错误
+func NewRegistry(toolchains []Toolchain) (*Registry, error) {
+	if len(toolchains) == 0 {
+		return nil, errors.New("no languages configured")
+	}
+	seen := make(map[property.Language]bool, len(toolchains))
+	for _, toolchain := range toolchains {
+		if err := toolchain.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[toolchain.Name] {
+			return nil, fmt.Errorf("duplicate language: %s", toolchain.Name)
+		}
+		seen[toolchain.Name] = true
+	}
+	return &Registry{toolchains: slices.Clone(toolchains)}, nil
+}
+
+// LoadRegistry 从 JSON 配置文件加载语言工具链注册表
+// - `file`：配置文件路径，文件内容为 Toolchain 数组
+func LoadRegistry(file string) (*Registry, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var toolchains []Toolchain
+	if err := json.Unmarshal(data, &toolchains); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", file, err)
+	}
+	return NewRegistry(toolchains)
+}
+
+// DefaultRegistry 返回内置的语言工具链注册表（未提供配置文件时使用）
+func DefaultRegistry() *Registry {
+	registry, err := NewRegistry([]Toolchain{
+		{
+			Name:           "C",
+			DisplayName:    "C",
+			Image:          "gcc:latest",
+			Extensions:     []string{".c", ".h"},
+			CompileCommand: "gcc -O2 -o {output}/main {entry}",
+			RunCommand:     "{output}/main",
+			DefaultEntry:   "main.c",
+		},
+		{
+			Name:         "PYTHON",
+			DisplayName:  "Python",
+			Image:        "python:3",
+			Extensions:   []string{".py"},
+			RunCommand:   "python3 {entry}",
+			DefaultEntry: "main.py",
+		},
+	})
+	if err != nil {
+		panic(err) // 内置配置错误属于编程错误
+	}
+	return registry
+}
+
+// Lookup 查找语言的工具链
+func (r *Registry) Lookup(language property.Language) (Toolchain, bool) {
+	for _, toolchain := range r.toolchains {
+		if toolchain.Name == language {
+			return toolchain, true
+		}
+	}
+	return Toolchain{}, false
+}
+
+// List 返回所有工具链（按配置顺序）
+func (r *Registry) List() []Toolchain {
+	return slices.Clone(r.toolchains)
+}
+
+// shellQuote 使用单引号转义 shell 参数
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
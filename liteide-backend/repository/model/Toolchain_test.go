@@ -0,0 +1,44 @@
+package model
+
+import "testing"
+
+func TestToolchainCommand(t *testing.T) {
+	tests := []struct {
+		name  string
+		chain Toolchain
+		entry string
+		want  string
+	}{
+		{
+			name:  "interpreted",
+			chain: Toolchain{RunCommand: "python3 {entry}"},
+			entry: "main.py",
+			want:  "python3 'main.py'",
+		},
+		{
+			name:  "compiled to output",
+			chain: Toolchain{CompileCommand: "gcc -o {output}/main {entry}", RunCommand: "{output}/main"},
+			entry: "src/main.c",
+			want:  "gcc -o '/tmp/run-1'/main 'src/main.c' && '/tmp/run-1'/main",
+		},
+		{
+			name:  "class from entry",
+			chain: Toolchain{CompileCommand: "javac -d {output} {entry}", RunCommand: "java -cp {output} {class}"},
+			entry: "src/Hello.java",
+			want:  "javac -d '/tmp/run-1' 'src/Hello.java' && java -cp '/tmp/run-1' 'Hello'",
+		},
+		{
+			name:  "quoted entry",
+			chain: Toolchain{RunCommand: "python3 {entry}"},
+			entry: "it's.py",
+			want:  `python3 'it'\''s.py'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.chain.Command(tt.entry, "/tmp/run-1"); got != tt.want {
+				t.Errorf("Command() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
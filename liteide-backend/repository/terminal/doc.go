@@ -0,0 +1,22 @@
+// Package terminal 定义浏览器终端与后端之间的 WebSocket 帧协议
+//
+// 每个 WebSocket 消息（文本帧或二进制帧均可）即一个协议帧：
+// 第 1 个字节为消息类型，其余字节为载荷。服务端统一以二进制帧发送。
+//
+//	类型  方向            载荷                        说明
+//	'0'   双向            原始字节                    终端数据（客户端为 stdin，服务端为 stdout）
+//	'1'   客户端->服务端  {"cols": 120, "rows": 40}   调整终端尺寸
+//	'2'   客户端->服务端  任意（原样返回）             心跳请求
+//	'3'   服务端->客户端  心跳请求的载荷              心跳响应
+//	'4'   客户端->服务端  {"signal": "SIGINT"}        向前台进程发送信号
+//	'5'   客户端->服务端  无                          客户端请求结束会话
+//	'6'   服务端->客户端  {"status": "attached"}      会话状态变更（attached、idle）
+//	'7'   服务端->客户端  {"code": 0}                 Exec 进程退出，随后服务端关闭连接
+//	'8'   服务端->客户端  {"message": "..."}          服务端错误，随后服务端关闭连接
+//	'9'   服务端->客户端  原始字节                    标准错误输出（仅非 TTY 的程序运行）
+//
+// 程序运行连接（/ws/container/:id/run）复用同一协议：'0' 为标准输出，'9' 为标准错误，
+// 结束时的 exit 帧额外包含 duration_ms 与 timed_out 字段。
+//
+// 终端数据帧的载荷不做任何转义，可安全传输 UTF-8 粘贴内容与 Ctrl 控制序列。
+package terminal
@@ -0,0 +1,150 @@
+package terminal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MessageType 协议帧的消息类型（帧的第 1 个字节）
+type MessageType byte
+
+// 协议帧消息类型，详见包文档
+const (
+	MessageData   MessageType = '0' // 终端数据
+	MessageResize MessageType = '1' // 调整终端尺寸
+	MessagePing   MessageType = '2' // 心跳请求
+	MessagePong   MessageType = '3' // 心跳响应
+	MessageSignal MessageType = '4' // 发送信号
+	MessageClose  MessageType = '5' // 结束会话
+	MessageStatus MessageType = '6' // 会话状态
+	MessageExit   MessageType = '7' // 进程退出
+	MessageError  MessageType = '8' // 服务端错误
+	MessageStderr MessageType = '9' // 标准错误输出
+)
+
+// 会话状态
+const (
+	StatusAttached = "attached" // 已附加到 Exec 进程
+	StatusIdle     = "idle"     // 容器空闲，即将被自动删除
+)
+
+// 协议解析错误
+var (
+	ErrEmptyFrame     = errors.New("empty frame")          // 帧没有类型字节
+	ErrUnknownMessage = errors.New("unknown message type") // 未知的消息类型
+	ErrInvalidPayload = errors.New("invalid payload")      // 载荷格式不合法
+)
+
+// Valid 判断消息类型是否为协议定义的类型
+func (t MessageType) Valid() bool {
+	return t >= MessageData && t <= MessageStderr
+}
+
+// Frame 解码后的协议帧
+type Frame struct {
+	Type    MessageType // 消息类型
+	Payload []byte      // 消息载荷
+}
+
+// Resize 调整终端尺寸的载荷
+type Resize struct {
+	Cols uint `json:"cols"` // 终端列数
+	Rows uint `json:"rows"` // 终端行数
+}
+
+// Signal 发送信号的载荷
+type Signal struct {
+	Signal string `json:"signal"` // 信号名称，例如 SIGINT
+}
+
+// Status 会话状态的载荷
+type Status struct {
+	Status string `json:"status"`           // 会话状态
+	Detail string `json:"detail,omitempty"` // 附加说明
+}
+
+// Exit 进程退出的载荷
+type Exit struct {
+	Code       int   `json:"code"`                  // 进程退出码
+	DurationMs int64 `json:"duration_ms,omitempty"` // 运行耗时（毫秒），仅运行程序时提供
+	TimedOut   bool  `json:"timed_out,omitempty"`   // 是否因超时被终止，仅运行程序时提供
+}
+
+// Error 服务端错误的载荷
+type Error struct {
+	Message string `json:"message"` // 错误信息
+}
+
+// signalSequences TTY 模式下信号对应的控制字符，由 PTY 行规程转换为信号
+var signalSequences = map[string][]byte{
+	"SIGINT":  {0x03}, // Ctrl+C
+	"SIGQUIT": {0x1c}, // Ctrl+\
+	"SIGTSTP": {0x1a}, // Ctrl+Z
+	"EOF":     {0x04}, // Ctrl+D
+}
+
+// Encode 将消息类型和载荷编码为协议帧
+func Encode(t MessageType, payload []byte) []byte {
+	frame := make([]byte, 0, len(payload)+1)
+	frame = append(frame, byte(t))
+	return append(frame, payload...)
+}
+
+// EncodeJSON 将消息类型和 JSON 载荷编码为协议帧
+func EncodeJSON(t MessageType, v any) ([]byte, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return Encode(t, payload), nil
+}
+
+// Decode 将 WebSocket 消息解码为协议帧
+// - 载荷与原消息共享底层数组，调用方不得在读取下一条消息后继续持有
+func Decode(p []byte) (Frame, error) {
+	if len(p) == 0 {
+		return Frame{}, ErrEmptyFrame
+	}
+	t := MessageType(p[0])
+	if !t.Valid() {
+		return Frame{}, fmt.Errorf("%w: %q", ErrUnknownMessage, p[0])
+	}
+	return Frame{Type: t, Payload: p[1:]}, nil
+}
+
+// Resize 解析调整终端尺寸的载荷，行列数必须大于 0
+func (f Frame) Resize() (Resize, error) {
+	var resize Resize
+	if err := f.unmarshal(MessageResize, &resize); err != nil {
+		return Resize{}, err
+	}
+	if resize.Cols == 0 || resize.Rows == 0 {
+		return Resize{}, fmt.Errorf("%w: cols and rows must be positive", ErrInvalidPayload)
+	}
+	return resize, nil
+}
+
+// Signal 解析发送信号的载荷，并返回写入 TTY 的控制字符
+func (f Frame) Signal() ([]byte, error) {
+	var signal Signal
+	if err := f.unmarshal(MessageSignal, &signal); err != nil {
+		return nil, err
+	}
+	sequence, ok := signalSequences[signal.Signal]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported signal %q", ErrInvalidPayload, signal.Signal)
+	}
+	return sequence, nil
+}
+
+// unmarshal 校验消息类型并解析 JSON 载荷
+func (f Frame) unmarshal(t MessageType, v any) error {
+	if f.Type != t {
+		return fmt.Errorf("%w: expected %q, got %q", ErrInvalidPayload, byte(t), byte(f.Type))
+	}
+	if err := json.Unmarshal(f.Payload, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package terminal
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     MessageType
+		payload []byte
+	}{
+		{name: "ascii input", typ: MessageData, payload: []byte("ls -al\r")},
+		{name: "utf-8 paste", typ: MessageData, payload: []byte("printf '你好，世界 🌏'\n")},
+		{name: "ctrl sequence", typ: MessageData, payload: []byte{0x03, 0x1b, '[', 'A'}},
+		{name: "empty payload", typ: MessageClose, payload: nil},
+		{name: "ping", typ: MessagePing, payload: []byte("42")},
+		{name: "stderr", typ: MessageStderr, payload: []byte("main.c:1: error\n")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frame, err := Decode(Encode(tt.typ, tt.payload))
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if frame.Type != tt.typ {
+				t.Errorf("Type = %q, want %q", frame.Type, tt.typ)
+			}
+			if !bytes.Equal(frame.Payload, tt.payload) {
+				t.Errorf("Payload = %q, want %q", frame.Payload, tt.payload)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		message []byte
+		want    error
+	}{
+		{name: "empty", message: nil, want: ErrEmptyFrame},
+		{name: "raw keystroke", message: []byte("ls"), want: ErrUnknownMessage},
+		{name: "legacy json", message: []byte(`{"type":"resize"}`), want: ErrUnknownMessage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.message); !errors.Is(err, tt.want) {
+				t.Errorf("Decode() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrameResize(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    Resize
+		wantErr bool
+	}{
+		{name: "valid", message: `1{"cols":120,"rows":40}`, want: Resize{Cols: 120, Rows: 40}},
+		{name: "zero rows", message: `1{"cols":120,"rows":0}`, wantErr: true},
+		{name: "malformed json", message: `1{"cols":`, wantErr: true},
+		{name: "wrong type", message: `0{"cols":120,"rows":40}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frame, err := Decode([]byte(tt.message))
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			got, err := frame.Resize()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Resize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrameSignal(t *testing.T) {
+	tests := []struct {
+		name    string
+		signal  string
+		want    []byte
+		wantErr bool
+	}{
+		{name: "interrupt", signal: "SIGINT", want: []byte{0x03}},
+		{name: "suspend", signal: "SIGTSTP", want: []byte{0x1a}},
+		{name: "unsupported", signal: "SIGKILL", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := EncodeJSON(MessageSignal, Signal{Signal: tt.signal})
+			if err != nil {
+				t.Fatalf("EncodeJSON() error = %v", err)
+			}
+			frame, err := Decode(message)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			got, err := frame.Signal()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Signal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Signal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeJSONEvents(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  MessageType
+		v    any
+		want string
+	}{
+		{name: "status", typ: MessageStatus, v: Status{Status: StatusAttached}, want: `6{"status":"attached"}`},
+		{name: "exit", typ: MessageExit, v: Exit{Code: 127}, want: `7{"code":127}`},
+		{name: "run exit", typ: MessageExit, v: Exit{Code: 137, DurationMs: 10000, TimedOut: true}, want: `7{"code":137,"duration_ms":10000,"timed_out":true}`},
+		{name: "error", typ: MessageError, v: Error{Message: "container is not running"}, want: `8{"message":"container is not running"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeJSON(tt.typ, tt.v)
+			if err != nil {
+				t.Fatalf("EncodeJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("EncodeJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,18 +3,82 @@ package utils
 import (
 	"bufio"
 	"context"
+	"errors"
 	"github.com/gofiber/contrib/websocket" // 引入 Fiber WebSocket 库
 	"github.com/gofiber/fiber/v2/log"      // 引入 Fiber 的日志库
 	"io"                                   // 引入标准 I/O 库，用于流式读写
+	"liteide-backend/repository/terminal"  // 引入终端 WebSocket 帧协议
+	"net"                                  // 引入 net 库，用于识别超时错误
 	"sync"                                 // 引入 sync 库，用于同步控制
+	"unicode/utf8"                         // 引入 utf8 库，用于按字符边界截断关闭原因
 )
 
+// maxCloseReason 关闭原因的最大字节数：控制帧载荷最多 125 字节，其中 2 字节为关闭码
+const maxCloseReason = 123
+
+// CloseMessage 生成关闭帧的载荷
+// - `code`：WebSocket 关闭码
+// - `reason`：关闭原因，超过 123 字节时在 UTF-8 字符边界处截断
+func CloseMessage(code int, reason string) []byte {
+	if len(reason) > maxCloseReason {
+		cut := maxCloseReason
+		for cut > 0 && !utf8.RuneStart(reason[cut]) {
+			cut--
+		}
+		reason = reason[:cut]
+	}
+	return websocket.FormatCloseMessage(code, reason)
+}
+
+// WSConn 带写锁的 WebSocket 连接
+// - 终端输出转发与心跳响应在不同 goroutine 中写入，需要串行化
+type WSConn struct {
+	*websocket.Conn
+	mu sync.Mutex
+}
+
+// NewWSConn 包装 WebSocket 连接
+func NewWSConn(conn *websocket.Conn) *WSConn {
+	return &WSConn{Conn: conn}
+}
+
+// WriteFrame 以二进制帧发送一个协议帧
+// - `t`：消息类型
+// - `payload`：消息载荷
+func (c *WSConn) WriteFrame(t terminal.MessageType, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.WriteMessage(websocket.BinaryMessage, terminal.Encode(t, payload))
+}
+
+// WriteEvent 以二进制帧发送一个 JSON 载荷的协议帧
+// - `t`：消息类型
+// - `v`：载荷对象，例如 terminal.Exit
+func (c *WSConn) WriteEvent(t terminal.MessageType, v any) error {
+	message, err := terminal.EncodeJSON(t, v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.WriteMessage(websocket.BinaryMessage, message)
+}
+
+// WriteClose 发送关闭帧
+// - `code`：WebSocket 关闭码
+// - `reason`：关闭原因，过长时截断，完整信息应通过错误帧发送
+func (c *WSConn) WriteClose(code int, reason string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.WriteMessage(websocket.CloseMessage, CloseMessage(code, reason))
+}
+
 // WSWriterCopy 从 `reader` 读取数据，并通过 WebSocket 连接 `writer` 发送数据
 // - `reader`：`bufio.Reader` 作为数据源
-// - `writer`：`websocket.Conn` 作为数据目标（WebSocket 连接）
+// - `writer`：`WSConn` 作为数据目标（WebSocket 连接），数据以终端数据帧发送
 // - `wg`：等待组，用于同步多个 goroutine
 // - `cancel`：取消函数，触发上下文取消
-func WSWriterCopy(reader *bufio.Reader, writer *websocket.Conn, wg *sync.WaitGroup, cancel context.CancelFunc) {
+func WSWriterCopy(reader *bufio.Reader, writer *WSConn, wg *sync.WaitGroup, cancel context.CancelFunc) {
 	// 定义缓冲区（1024 字节）
 	buf := make([]byte, 1024)
 
@@ -29,8 +93,8 @@ func WSWriterCopy(reader *bufio.Reader, writer *websocket.Conn, wg *sync.WaitGro
 
 		// 如果成功读取了数据
 		if nr > 0 {
-			// 通过 WebSocket 发送数据（以 BinaryMessage 类型发送）
-			err := writer.WriteMessage(websocket.BinaryMessage, buf[0:nr])
+			// 通过 WebSocket 发送终端数据帧
+			err := writer.WriteFrame(terminal.MessageData, buf[0:nr])
 			if err != nil {
 				return // 发送失败，直接退出
 			}
@@ -43,12 +107,16 @@ func WSWriterCopy(reader *bufio.Reader, writer *websocket.Conn, wg *sync.WaitGro
 	}
 }
 
-// WSReaderCopy 从 WebSocket 连接 `reader` 读取数据，并写入 `writer`（通常是标准输出或文件）
-// - `reader`：WebSocket 连接，作为数据源
+// ResizeFunc 终端尺寸调整回调
+type ResizeFunc func(cols uint, rows uint)
+
+// WSReaderCopy 从 WebSocket 连接 `reader` 读取协议帧，并将终端数据写入 `writer`（通常是 Exec 的标准输入）
+// - `reader`：WebSocket 连接，作为数据源，文本帧与二进制帧按相同协议解析
 // - `writer`：`io.Writer` 作为数据目标
+// - `resize`：收到尺寸调整帧时的回调，为 nil 时忽略尺寸调整
 // - `wg`：等待组，用于同步多个 goroutine
 // - `cancel`：取消函数，触发上下文取消
-func WSReaderCopy(reader *websocket.Conn, writer io.Writer, wg *sync.WaitGroup, cancel context.CancelFunc) {
+func WSReaderCopy(reader *WSConn, writer io.Writer, resize ResizeFunc, wg *sync.WaitGroup, cancel context.CancelFunc) {
 	// 确保在函数结束时取消上下文
 	defer cancel()
 	// 确保 `wg.Done()` 被调用，减少等待组计数
@@ -58,20 +126,62 @@ func WSReaderCopy(reader *websocket.Conn, writer io.Writer, wg *sync.WaitGroup,
 		// 从 WebSocket 连接读取消息
 		messageType, p, err := reader.ReadMessage()
 		if err != nil {
-			// 如果错误不是正常关闭或客户端断开，则记录错误
+			// 如果错误不是正常关闭、客户端断开或主动设置的读超时，则记录错误
+			var netErr net.Error
 			if !websocket.IsCloseError(err, websocket.CloseNormalClosure) &&
-				!websocket.IsCloseError(err, websocket.CloseGoingAway) {
+				!websocket.IsCloseError(err, websocket.CloseGoingAway) &&
+				!(errors.As(err, &netErr) && netErr.Timeout()) {
 				log.Errorf("failed to read from ws: %v", err)
 			}
 			return // 发生错误时，退出循环
 		}
 
-		// 只处理 TextMessage 类型的消息
-		if messageType == websocket.TextMessage {
-			_, err := writer.Write(p)
-			if err != nil {
+		// 只处理数据消息（文本帧或二进制帧）
+		if messageType != websocket.TextMessage && messageType != websocket.BinaryMessage {
+			continue
+		}
+
+		// 解析协议帧，非法帧直接丢弃
+		frame, err := terminal.Decode(p)
+		if err != nil {
+			log.Debugf("dropping invalid ws frame: %v", err)
+			continue
+		}
+
+		switch frame.Type {
+		case terminal.MessageData:
+			// 终端输入原样写入
+			if _, err := writer.Write(frame.Payload); err != nil {
 				return // 写入失败，直接退出
 			}
+		case terminal.MessageResize:
+			size, err := frame.Resize()
+			if err != nil {
+				log.Debugf("dropping invalid resize frame: %v", err)
+				continue
+			}
+			if resize != nil {
+				resize(size.Cols, size.Rows)
+			}
+		case terminal.MessageSignal:
+			// TTY 模式下通过控制字符向前台进程发送信号
+			sequence, err := frame.Signal()
+			if err != nil {
+				log.Debugf("dropping invalid signal frame: %v", err)
+				continue
+			}
+			if _, err := writer.Write(sequence); err != nil {
+				return
+			}
+		case terminal.MessagePing:
+			// 原样返回心跳载荷
+			if err := reader.WriteFrame(terminal.MessagePong, frame.Payload); err != nil {
+				return
+			}
+		case terminal.MessageClose:
+			return // 客户端请求结束会话
+		default:
+			log.Debugf("dropping unexpected ws frame type: %q", byte(frame.Type))
 		}
 	}
 }
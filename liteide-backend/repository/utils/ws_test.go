@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCloseMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason string
+		want   string
+	}{
+		{name: "short", reason: "invalid container id", want: "invalid container id"},
+		{name: "exact", reason: strings.Repeat("a", 123), want: strings.Repeat("a", 123)},
+		{name: "ascii", reason: strings.Repeat("a", 200), want: strings.Repeat("a", 123)},
+		// 每个汉字 3 字节，123 字节恰好是 41 个汉字
+		{name: "multibyte", reason: "x" + strings.Repeat("容", 60), want: "x" + strings.Repeat("容", 40)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message := CloseMessage(1011, tt.reason)
+			if len(message) > 125 {
+				t.Fatalf("close payload is %d bytes, want at most 125", len(message))
+			}
+			if code := binary.BigEndian.Uint16(message); code != 1011 {
+				t.Errorf("code = %d, want 1011", code)
+			}
+			reason := string(message[2:])
+			if reason != tt.want || !utf8.ValidString(reason) {
+				t.Errorf("reason = %q, want %q", reason, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,21 @@ package router
 
 import (
 	"errors"                               // 标准错误处理包
+	"fmt"                                  // 用于包装错误信息
 	"github.com/gofiber/contrib/websocket" // 引入 Fiber 的 WebSocket 库
 	"github.com/gofiber/fiber/v2"          // 引入 Fiber Web 框架
+	"io/fs"                                // 用于识别文件不存在、已存在等错误
+	"liteide-backend/ent"                  // 引入 ent ORM，用于识别数据库错误
+	"liteide-backend/ent/property"         // 引入用户角色枚举
+	"liteide-backend/service"              // 引入服务层，用于识别业务错误和校验令牌
 	"strconv"                              // 用于字符串转换，如分页参数解析
+	"strings"                              // 用于解析 Authorization 与子协议请求头
 )
 
+// wsTokenProtocol 通过 WebSocket 子协议传递令牌时使用的协议名
+// 例如：new WebSocket(url, ["bearer", token])
+const wsTokenProtocol = "bearer"
+
 // ErrorHandler 统一错误处理函数
 // - `c`：Fiber 上下文对象
 // - `err`：发生的错误
@@ -14,10 +24,41 @@ func ErrorHandler(c *fiber.Ctx, err error) error {
 	// 默认返回 500 服务器内部错误
 	code := fiber.StatusInternalServerError
 
-	// 如果错误是 *fiber.Error 类型，则获取其错误码
+	// 根据错误类型映射 HTTP 状态码
 	var e *fiber.Error
-	if errors.As(err, &e) {
-		code = e.Code
+	switch {
+	case errors.As(err, &e):
+		code = e.Code // *fiber.Error 自带错误码
+	case ent.IsNotFound(err):
+		code = fiber.StatusNotFound // 数据库记录不存在
+	case ent.IsValidationError(err), ent.IsConstraintError(err):
+		code = fiber.StatusBadRequest // 字段校验或约束失败
+	case errors.Is(err, service.ErrInvalidCredentials), errors.Is(err, service.ErrUnauthenticated):
+		code = fiber.StatusUnauthorized // 未登录或令牌无效
+	case errors.Is(err, service.ErrForbidden):
+		code = fiber.StatusForbidden // 角色权限不足；其他用户的资源按不存在处理，返回 404
+	case errors.Is(err, service.ErrQuotaExceeded):
+		code = fiber.StatusForbidden // 超出用户配额
+	case errors.Is(err, service.ErrInvalidPassword):
+		code = fiber.StatusBadRequest // 密码不满足要求
+	case errors.Is(err, service.ErrInvalidPath), errors.Is(err, service.ErrInvalidRun), errors.Is(err, service.ErrUnknownLanguage),
+		errors.Is(err, service.ErrInvalidImage):
+		code = fiber.StatusBadRequest // 文件路径、运行参数、语言或镜像不合法
+	case errors.Is(err, fs.ErrNotExist):
+		code = fiber.StatusNotFound // 文件或目录不存在
+	case errors.Is(err, fs.ErrExist):
+		code = fiber.StatusConflict // 文件或目录已存在
+	case errors.Is(err, service.ErrFileTooLarge):
+		code = fiber.StatusRequestEntityTooLarge // 文件过大
+	case errors.Is(err, service.ErrContainerNotRunning), errors.Is(err, service.ErrWorkspaceInUse),
+		errors.Is(err, service.ErrImageInUse):
+		code = fiber.StatusConflict // 资源状态不允许当前操作
+	case errors.Is(err, service.ErrInstanceNotFound):
+		code = fiber.StatusServiceUnavailable // 容器实例尚未就绪
+	case errors.Is(err, service.ErrContainerStartFailed):
+		code = fiber.StatusBadGateway // 容器实例未能启动
+	case errors.Is(err, service.ErrDocker):
+		code = fiber.StatusBadGateway // Docker 守护进程调用失败
 	}
 
 	// 返回 JSON 格式的错误信息
@@ -37,6 +78,58 @@ func useWS(c *fiber.Ctx) error {
 	return fiber.ErrUpgradeRequired
 }
 
+// useAuth 身份认证中间件
+// - 校验访问令牌，并将当前用户（*ent.User）写入 c.Locals("user")
+// - 令牌通过 `Authorization: Bearer <token>` 请求头传递
+// - 浏览器无法为 WebSocket 设置请求头，因此 WebSocket 握手还可以使用 `?token=<token>` 或子协议 `bearer, <token>`
+func useAuth(auth *service.AuthService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token := requestToken(c)
+		if token == "" {
+			return fmt.Errorf("%w: missing token", service.ErrUnauthenticated)
+		}
+
+		user, err := auth.Authenticate(c.UserContext(), token)
+		if err != nil {
+			return err
+		}
+		c.Locals("user", user)
+		return c.Next()
+	}
+}
+
+// requestToken 从请求中提取访问令牌，不存在时返回空字符串
+func requestToken(c *fiber.Ctx) string {
+	if token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer "); ok {
+		return strings.TrimSpace(token)
+	}
+	if !websocket.IsWebSocketUpgrade(c) {
+		return ""
+	}
+	if token := c.Query("token"); token != "" {
+		return token
+	}
+	// 子协议列表形如 "bearer, <token>"，令牌紧跟在协议名之后
+	protocols := strings.Split(c.Get(fiber.HeaderSecWebSocketProtocol), ",")
+	for i := 0; i+1 < len(protocols); i++ {
+		if strings.TrimSpace(protocols[i]) == wsTokenProtocol {
+			return strings.TrimSpace(protocols[i+1])
+		}
+	}
+	return ""
+}
+
+// useAdmin 管理员权限检查中间件，需要在 useAuth 之后使用
+func useAdmin() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		user, _ := c.Locals("user").(*ent.User)
+		if user == nil || user.Role != property.UserRoleAdmin {
+			return fmt.Errorf("%w: admin role required", service.ErrForbidden)
+		}
+		return c.Next()
+	}
+}
+
 // usePagination 分页参数解析中间件
 // 作用：解析 `page` 和 `size` 参数，并计算 `offset` 和 `limit` 值
 func usePagination() fiber.Handler {
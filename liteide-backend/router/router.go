@@ -3,31 +3,154 @@ package router
 import (
 	"github.com/gofiber/contrib/websocket" // 引入 Fiber WebSocket 支持库
 	"github.com/gofiber/fiber/v2"          // 引入 Fiber Web 框架
+	"liteide-backend/config"               // 引入应用配置
 	"liteide-backend/controller"           // 引入控制器，用于处理 HTTP 请求
+	"liteide-backend/service"              // 引入服务层，控制器由服务构造
 )
 
 // UseRouter 负责注册 API 和 WebSocket 相关的路由
 // - `app`：Fiber Web 服务器实例
-func UseRouter(app *fiber.App) {
+// - `conf`：应用配置
+// - `services`：控制器与中间件使用的服务，与后台协程共享
+func UseRouter(app *fiber.App, conf config.AppConfig, services *service.Services) {
+	auth := controller.NewAuthController(services.Auth, services.Workspaces, conf.QuotaConfig)
+	workspaces := controller.NewWorkspaceController(services.Workspaces)
+	containers := controller.NewContainerController(services.Containers)
+	images := controller.NewImageController(services.Images)
+
+	// WebSocket 处理器的配置：接受 `bearer` 子协议，使通过子协议传递令牌的浏览器客户端握手成功
+	wsConfig := websocket.Config{Subprotocols: []string{wsTokenProtocol}}
+
+	app.Post("/auth/login", auth.Login)
+	// 登录，无需令牌
+	// Body: {"username": "alice", "password": "secret123"}
+	// 返回：{"token": "...", "expire_time": "...", "user": {...}}
+
+	app.Use(useAuth(services.Auth))
+	// 中间件，之后注册的所有路由都需要有效的访问令牌
+	// 请求头：Authorization: Bearer <token>
+
+	app.Get("/me", auth.GetMe)
+	// 查询当前登录用户
+
+	app.Get("/me/usage", auth.GetUsage)
+	// 查询当前用户的资源占用与配额
+	// 返回：{"containers": {"used": 1, "limit": 2}, "workspaces": {...}, "disk_bytes": {...}}
+
+	app.Use("/admin", useAdmin())
+	// 中间件，`/admin` 开头的路由仅管理员可用
+
+	app.Post("/admin/user", auth.CreateUser)
+	// 创建用户
+	// Body: {"username": "alice", "password": "secret123", "role": "USER"}
+
 	// 注册 HTTP API 路由
-	app.Post("/container", controller.CreateContainer)
-	// 处理创建容器请求（POST 方法）
+	app.Post("/container", containers.CreateContainer)
+	// 处理创建容器请求（POST 方法），容器属于当前登录用户
 	// 例如：POST /container
-	// Body: {"image": "nginx", "name": "my-container"}
-	// 返回：{"id": "abc123456", "status": "created"}
+	// Body: {"workspace_id": 2}
+	// 返回：{"id": 123, "status": "created"}
 
-	app.Delete("/container/:id<int>", controller.RemoveContainer)
+	app.Get("/container", usePagination(), containers.ListContainers)
+	// 分页查询当前用户的容器（管理员可查询全部），可按状态、工作区和语言过滤
+	// 例如：GET /container?page=1&size=10&status=UP&workspace_id=2&language=C
+	// 返回：{"total": 1, "items": [...]}
+
+	app.Get("/container/:id<int>", containers.GetContainer)
+	// 查询容器详情，包含镜像、工作区、创建与删除时间以及 容器实例的实时状态
+	// 返回：{"id": 123, "status": "UP", ..., "task": {"state": "running", ...}}
+
+	app.Delete("/container/:id<int>", containers.RemoveContainer)
 	// 处理删除指定 ID 容器的请求（DELETE 方法）
 	// 例如：DELETE /container/123
 	// 返回：{"id": 123, "status": "removed"}
 
+	app.Get("/language", images.ListLanguages)
+	// 查询可用的编程语言（来自语言工具链配置）
+
+	app.Get("/admin/image", usePagination(), images.ListImages)
+	// 分页查询镜像目录，包含各镜像的预拉取状态
+	// 例如：GET /admin/image?page=1&size=10
+
+	app.Post("/admin/image", images.CreateImage)
+	// 为语言添加镜像，创建后在后台预拉取到所有 Swarm 节点
+	// Body: {"language": "C", "image_name": "gcc:13", "digest": "sha256:…"}
+
+	app.Patch("/admin/image/:id<int>", images.UpdateImage)
+	// 更新镜像，镜像名称或摘要变更后重新预拉取
+	// Body: {"image_name": "gcc:14", "digest": ""}
+
+	app.Delete("/admin/image/:id<int>", images.DeleteImage)
+	// 删除镜像（仍被容器记录引用时拒绝）
+
+	app.Post("/admin/image/:id<int>/pull", images.PullImage)
+	// 重新预拉取镜像
+	// 返回：{"id": 1, "status": "queued"}
+
+	app.Post("/workspace", workspaces.CreateWorkspace)
+	// 创建工作区及其目录
+	// 例如：POST /workspace
+	// Body: {"name": "hello", "language": "C"}
+
+	app.Get("/workspace", usePagination(), workspaces.ListWorkspaces)
+	// 分页查询当前用户的工作区（管理员可查询全部）
+	// 例如：GET /workspace?page=1&size=10
+	// 返回：{"total": 1, "items": [...]}
+
+	app.Get("/workspace/:id<int>", workspaces.GetWorkspace)
+	// 查询指定 ID 的工作区
+
+	app.Patch("/workspace/:id<int>", workspaces.RenameWorkspace)
+	// 重命名工作区
+	// Body: {"name": "new-name"}
+
+	app.Delete("/workspace/:id<int>", workspaces.DeleteWorkspace)
+	// 删除工作区及其目录（工作区内不能有运行中的容器）
+
+	app.Get("/workspace/:id<int>/files", workspaces.ListFiles)
+	// 列出工作区内目录的直接子项
+	// 例如：GET /workspace/1/files?path=src
+
+	app.Post("/workspace/:id<int>/files", workspaces.CreateFile)
+	// 创建空文件或目录
+	// Body: {"path": "src", "is_dir": true}
+
+	app.Delete("/workspace/:id<int>/files", workspaces.DeleteFile)
+	// 删除文件或目录（目录递归删除）
+	// 例如：DELETE /workspace/1/files?path=src/main.c
+
+	app.Post("/workspace/:id<int>/files/move", workspaces.MoveFile)
+	// 重命名或移动文件、目录
+	// Body: {"from": "main.c", "to": "src/main.c"}
+
+	app.Get("/workspace/:id<int>/files/content", workspaces.ReadFile)
+	// 读取文件内容
+	// 例如：GET /workspace/1/files/content?path=src/main.c
+
+	app.Put("/workspace/:id<int>/files/content", workspaces.WriteFile)
+	// 创建或覆盖文件，请求体为文件原始内容
+
+	app.Post("/container/:id<int>/run", containers.RunContainer)
+	// 在容器内编译并运行工作区程序，运行结束后返回输出
+	// Body: {"file": "main.c", "timeout": 10}
+	// 返回：{"stdout": "...", "stderr": "...", "exit_code": 0, "duration_ms": 120, "timed_out": false}
+
 	// WebSocket 相关路由
 	app.Use("/ws", useWS)
-	// 中间件，针对所有 `/ws` 开头的 WebSocket 路由执行额外逻辑（如身份验证）
-	// 例如：拦截非授权用户或日志记录
+	// 中间件，拒绝 `/ws` 开头路由上的非 WebSocket 请求
+	// 身份认证由 useAuth 完成，令牌可通过 `?token=<token>` 或子协议 `bearer, <token>` 传递
 
-	app.Get("/ws/container/:id<int>", websocket.New(controller.AttachContainer))
+	app.Get("/ws/container/:id<int>", websocket.New(containers.AttachContainer, wsConfig))
 	// WebSocket 连接到指定 ID 的 Docker 容器（GET 方法）
-	// 例如：ws://localhost:8080/ws/container/123
+	// 例如：ws://localhost:8080/ws/container/123?token=<token>
 	// 用于获取容器的实时日志或交互式终端
+
+	app.Get("/ws/container/:id<int>/run", websocket.New(containers.RunContainerWS, wsConfig))
+	// WebSocket 流式运行工作区程序，实时推送标准输出与标准错误
+	// 例如：ws://localhost:8080/ws/container/123/run?file=main.c&timeout=10
+
+	app.Get("/ws/workspace/:id<int>/events", websocket.New(workspaces.WatchFiles, wsConfig))
+	// WebSocket 推送指定工作区的文件变更事件
+	// 例如：ws://localhost:8080/ws/workspace/1/events
+	// 推送：{"events": [{"type": "modify", "path": "src/main.c"}]}
 }
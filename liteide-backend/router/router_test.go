@@ -0,0 +1,136 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"github.com/gofiber/fiber/v2"
+	"io"
+	"liteide-backend/config"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/db"
+	"liteide-backend/repository/docker"
+	"liteide-backend/repository/model"
+	"liteide-backend/service"
+	"liteide-backend/svc"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// testApp 测试用的应用：alice 拥有一个工作区和一个已删除的容器
+type testApp struct {
+	app         *fiber.App
+	workspaceId int               // alice 的工作区 ID
+	containerId int               // alice 的容器记录 ID
+	tokens      map[string]string // 用户名到访问令牌的映射
+}
+
+// setupApp 使用内存 SQLite 与内存容器运行时创建应用，并为每个用户签发访问令牌
+func setupApp(t *testing.T) testApp {
+	t.Helper()
+	databaseConfig := config.DatabaseConfig{Driver: config.DatabaseSQLite, Database: config.SQLiteMemory}
+	client, err := db.Open(databaseConfig)
+	if err != nil {
+		t.Fatalf("db.Open() error = %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	db.Migrate(client)
+
+	conf := config.AppConfig{
+		DatabaseConfig: databaseConfig,
+		DataDirectory:  t.TempDir(),
+		AuthConfig:     config.AuthConfig{JWTSecret: "secret", TokenTTL: time.Hour},
+	}
+	services := service.NewServices(&svc.ServiceContext{
+		AppConfig: conf,
+		Database:  client,
+		Runtime:   docker.NewFakeRuntime(),
+		Languages: model.DefaultRegistry(),
+	})
+
+	ctx := context.Background()
+	a := testApp{tokens: make(map[string]string)}
+	for name, role := range map[string]property.UserRole{"alice": property.UserRoleUser, "bob": property.UserRoleUser, "root": property.UserRoleAdmin} {
+		if _, err := services.Auth.CreateUser(ctx, name, "password", role); err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+		token, user, err := services.Auth.Login(ctx, name, "password")
+		if err != nil {
+			t.Fatalf("Login() error = %v", err)
+		}
+		a.tokens[name] = token.Token
+		if name != "alice" {
+			continue
+		}
+
+		workspaceInstance, err := services.Workspaces.CreateWorkspace(ctx, user, "hello", "C", nil)
+		if err != nil {
+			t.Fatalf("CreateWorkspace() error = %v", err)
+		}
+		a.workspaceId = workspaceInstance.ID
+		a.containerId = client.Container.Create().
+			SetUserID(user.ID).
+			SetWorkspaceID(workspaceInstance.ID).
+			SetImage(client.Image.Create().SetLanguage("C").SetImageName("gcc:13").SaveX(ctx)).
+			SetContainerStatus(property.ContainerStatusRemoved).
+			SaveX(ctx).ID
+	}
+
+	a.app = fiber.New(fiber.Config{ErrorHandler: ErrorHandler})
+	UseRouter(a.app, conf, services)
+	return a
+}
+
+func TestAccessStatus(t *testing.T) {
+	a := setupApp(t)
+	workspace := fmt.Sprintf("/workspace/%d", a.workspaceId)
+	container := fmt.Sprintf("/container/%d", a.containerId)
+	tests := []struct {
+		name    string
+		user    string // 发起请求的用户，为空时不携带令牌
+		path    string
+		status  int
+		missing string // 对应的不存在资源的路径，两者的响应必须一致
+	}{
+		{name: "workspace owner", user: "alice", path: workspace, status: fiber.StatusOK},
+		{name: "workspace admin", user: "root", path: workspace, status: fiber.StatusOK},
+		// 其他用户的资源与不存在的资源返回相同的状态码与响应，无法据此探测 ID
+		{name: "workspace other user", user: "bob", path: workspace, status: fiber.StatusNotFound,
+			missing: fmt.Sprintf("/workspace/%d", a.workspaceId+100)},
+		{name: "container other user", user: "bob", path: container, status: fiber.StatusNotFound,
+			missing: fmt.Sprintf("/container/%d", a.containerId+100)},
+		// 角色权限不足仍返回 403
+		{name: "admin route as user", user: "alice", path: "/admin/image", status: fiber.StatusForbidden},
+		{name: "admin route as admin", user: "root", path: "/admin/image", status: fiber.StatusOK},
+		{name: "no token", path: workspace, status: fiber.StatusUnauthorized},
+	}
+	get := func(t *testing.T, user string, path string) (int, string) {
+		t.Helper()
+		req := httptest.NewRequest(fiber.MethodGet, path, nil)
+		if user != "" {
+			req.Header.Set(fiber.HeaderAuthorization, "Bearer "+a.tokens[user])
+		}
+		resp, err := a.app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := get(t, tt.user, tt.path)
+			if status != tt.status {
+				t.Errorf("GET %s status = %d, want %d", tt.path, status, tt.status)
+			}
+			if tt.missing == "" {
+				return
+			}
+			missingStatus, missingBody := get(t, tt.user, tt.missing)
+			if missingStatus != status || missingBody != body {
+				t.Errorf("GET %s = %d %s, missing resource = %d %s", tt.path, status, body, missingStatus, missingBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"liteide-backend/ent"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/workspace"
+)
+
+// visibleWorkspaces 返回用户可以访问的工作区查询
+// - 管理员可以访问所有工作区，其他用户只能访问自己的工作区
+// - 查询其他用户的工作区与查询不存在的工作区一样返回 ent.NotFoundError（404），无法据此探测 ID
+func visibleWorkspaces(client *ent.Client, user *ent.User) (*ent.WorkspaceQuery, error) {
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	query := client.Workspace.Query()
+	if user.Role != property.UserRoleAdmin {
+		query.Where(workspace.UserID(user.ID))
+	}
+	return query, nil
+}
+
+// visibleContainers 返回用户可以访问的容器记录查询
+// - 管理员可以访问所有容器记录，其他用户只能访问自己的容器记录
+// - 其他用户的容器记录同样按不存在处理
+func visibleContainers(client *ent.Client, user *ent.User) (*ent.ContainerQuery, error) {
+	if user == nil {
+		return nil, ErrUnauthenticated
+	}
+	query := client.Container.Query()
+	if user.Role != property.UserRoleAdmin {
+		query.Where(container.UserID(user.ID))
+	}
+	return query, nil
+}
+
+// getWorkspace 查询用户有权访问的工作区
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+func (s *WorkspaceService) getWorkspace(ctx context.Context, user *ent.User, workspaceId int) (*ent.Workspace, error) {
+	query, err := visibleWorkspaces(s.database, user)
+	if err != nil {
+		return nil, err
+	}
+	return query.Where(workspace.ID(workspaceId)).Only(ctx)
+}
+
+// getContainer 查询用户有权访问的容器记录
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `containerId`：容器记录 ID
+func (s *ContainerService) getContainer(ctx context.Context, user *ent.User, containerId int) (*ent.Container, error) {
+	query, err := visibleContainers(s.database, user)
+	if err != nil {
+		return nil, err
+	}
+	return query.Where(container.ID(containerId)).Only(ctx)
+}
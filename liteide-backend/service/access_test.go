@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"liteide-backend/config"
+	"liteide-backend/ent"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/db"
+	"liteide-backend/repository/docker"
+	"liteide-backend/repository/model"
+	"liteide-backend/svc"
+	"testing"
+	"time"
+)
+
+// fixture 测试数据：alice 拥有一个工作区和一个已删除的容器
+type fixture struct {
+	alice, bob, admin *ent.User
+	workspace         *ent.Workspace
+	container         *ent.Container
+	runtime           *docker.FakeRuntime
+	workspaces        *WorkspaceService
+	containers        *ContainerService
+	images            *ImageService
+	auth              *AuthService
+}
+
+// setupService 使用内存 SQLite 与内存容器运行时创建服务，并创建测试数据
+func setupService(t *testing.T) fixture {
+	t.Helper()
+	databaseConfig := config.DatabaseConfig{Driver: config.DatabaseSQLite, Database: config.SQLiteMemory}
+	client, err := db.Open(databaseConfig)
+	if err != nil {
+		t.Fatalf("db.Open() error = %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	db.Migrate(client)
+
+	runtime := docker.NewFakeRuntime()
+	services := NewServices(&svc.ServiceContext{
+		AppConfig: config.AppConfig{
+			DatabaseConfig:         databaseConfig,
+			ResourceConfig:         config.ResourceConfig{NanoCPUs: 1e9, MemoryBytes: 1 << 30, PidsLimit: 64},
+			NetworkConfig:          config.NetworkConfig{DefaultMode: property.NetworkModeNone},
+			ContainerServicePrefix: "liteide-pod-",
+			ContainerStartTimeout:  time.Second,
+			DataDirectory:          t.TempDir(),
+			RunTimeout:             10 * time.Second,
+			RunMaxTimeout:          60 * time.Second,
+			AuthConfig:             config.AuthConfig{JWTSecret: "secret", TokenTTL: time.Hour},
+		},
+		Database:  client,
+		Runtime:   runtime,
+		Languages: model.DefaultRegistry(),
+	})
+
+	ctx := context.Background()
+	newUser := func(name string, role property.UserRole) *ent.User {
+		return client.User.Create().SetUsername(name).SetPasswordHash("-").SetRole(role).SaveX(ctx)
+	}
+	f := fixture{
+		alice:      newUser("alice", property.UserRoleUser),
+		bob:        newUser("bob", property.UserRoleUser),
+		admin:      newUser("root", property.UserRoleAdmin),
+		runtime:    runtime,
+		workspaces: services.Workspaces,
+		containers: services.Containers,
+		images:     services.Images,
+		auth:       services.Auth,
+	}
+
+	f.workspace, err = f.workspaces.CreateWorkspace(ctx, f.alice, "hello", "C", nil)
+	if err != nil {
+		t.Fatalf("CreateWorkspace() error = %v", err)
+	}
+	imageInstance := client.Image.Create().SetLanguage("C").SetImageName("gcc:13").SaveX(ctx)
+	f.container = client.Container.Create().
+		SetUser(f.alice).
+		SetWorkspace(f.workspace).
+		SetImage(imageInstance).
+		SetContainerStatus(property.ContainerStatusRemoved).
+		SaveX(ctx)
+	return f
+}
+
+func TestWorkspaceAccess(t *testing.T) {
+	ops := []struct {
+		name     string
+		call     func(f fixture, ctx context.Context, user *ent.User, id int) error
+		ownerErr error // 所有者调用时的预期错误，nil 表示成功
+	}{
+		{name: "get", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			_, err := f.workspaces.GetWorkspace(ctx, user, id)
+			return err
+		}},
+		{name: "rename", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			_, err := f.workspaces.RenameWorkspace(ctx, user, id, "renamed")
+			return err
+		}},
+		{name: "delete", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			return f.workspaces.DeleteWorkspace(ctx, user, id)
+		}},
+		{name: "list files", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			_, err := f.workspaces.ListFiles(ctx, user, id, "")
+			return err
+		}},
+		{name: "write file", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			return f.workspaces.WriteFile(ctx, user, id, "main.c", []byte("int main() {}"))
+		}},
+		{name: "read file", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			_, err := f.workspaces.ReadFile(ctx, user, id, "missing.c")
+			return err
+		}, ownerErr: errNotExist},
+		{name: "watch", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			_, err := f.workspaces.WatchWorkspace(ctx, user, id)
+			return err
+		}},
+		{name: "create container", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			_, err := f.containers.CreateContainer(ctx, user, id)
+			return err
+		}},
+	}
+
+	for _, op := range ops {
+		for _, actor := range []string{"owner", "other", "admin", "missing"} {
+			t.Run(op.name+"/"+actor, func(t *testing.T) {
+				f := setupService(t)
+				user, id, want := f.alice, f.workspace.ID, op.ownerErr
+				switch actor {
+				case "other":
+					user, want = f.bob, errNotFound
+				case "admin":
+					user = f.admin
+				case "missing":
+					id, want = f.workspace.ID+100, errNotFound
+				}
+				checkErr(t, op.call(f, context.Background(), user, id), want)
+			})
+		}
+	}
+}
+
+func TestContainerAccess(t *testing.T) {
+	ops := []struct {
+		name string
+		call func(f fixture, ctx context.Context, user *ent.User, id int) error
+	}{
+		{name: "remove", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			return f.containers.RemoveContainer(ctx, user, id)
+		}},
+		{name: "attach", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			_, err := f.containers.AttachContainer(ctx, user, id)
+			return err
+		}},
+		{name: "run", call: func(f fixture, ctx context.Context, user *ent.User, id int) error {
+			_, err := f.containers.RunContainer(ctx, user, id, "", 0, io.Discard, io.Discard)
+			return err
+		}},
+	}
+
+	for _, op := range ops {
+		tests := []struct {
+			actor string
+			want  error
+		}{
+			// 通过权限检查后，已删除的容器返回 ErrContainerNotRunning
+			{actor: "owner", want: ErrContainerNotRunning},
+			{actor: "other", want: errNotFound},
+			{actor: "admin", want: ErrContainerNotRunning},
+			{actor: "missing", want: errNotFound},
+		}
+		for _, tt := range tests {
+			t.Run(op.name+"/"+tt.actor, func(t *testing.T) {
+				f := setupService(t)
+				user, id := f.alice, f.container.ID
+				switch tt.actor {
+				case "other":
+					user = f.bob
+				case "admin":
+					user = f.admin
+				case "missing":
+					id = f.container.ID + 100
+				}
+				checkErr(t, op.call(f, context.Background(), user, id), tt.want)
+			})
+		}
+	}
+}
+
+func TestListWorkspacesScope(t *testing.T) {
+	f := setupService(t)
+	tests := []struct {
+		name string
+		user *ent.User
+		want int
+	}{
+		{name: "owner", user: f.alice, want: 1},
+		{name: "other", user: f.bob, want: 0},
+		{name: "admin", user: f.admin, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, total, err := f.workspaces.ListWorkspaces(context.Background(), tt.user, 0, 10)
+			if err != nil {
+				t.Fatalf("ListWorkspaces() error = %v", err)
+			}
+			if total != tt.want || len(items) != tt.want {
+				t.Errorf("ListWorkspaces() = %d items, total %d, want %d", len(items), total, tt.want)
+			}
+		})
+	}
+}
+
+// 测试专用的预期错误标记
+var (
+	errNotFound = errors.New("ent not found")  // 预期 ent.NotFoundError
+	errNotExist = errors.New("file not exist") // 预期文件不存在
+)
+
+// checkErr 按预期错误标记校验错误
+func checkErr(t *testing.T, err error, want error) {
+	t.Helper()
+	switch want {
+	case nil:
+		if err != nil {
+			t.Errorf("error = %v, want nil", err)
+		}
+	case errNotFound:
+		if !ent.IsNotFound(err) {
+			t.Errorf("error = %v, want not found", err)
+		}
+	case errNotExist:
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("error = %v, want file not exist", err)
+		}
+	default:
+		if !errors.Is(err, want) {
+			t.Errorf("error = %v, want %v", err, want)
+		}
+	}
+}
+
+func TestListContainersScope(t *testing.T) {
+	f := setupService(t)
+	removed := property.ContainerStatusRemoved
+	up := property.ContainerStatusUp
+	tests := []struct {
+		name   string
+		user   *ent.User
+		filter ContainerFilter
+		want   int
+	}{
+		{name: "owner", user: f.alice, want: 1},
+		{name: "other", user: f.bob, want: 0},
+		{name: "admin", user: f.admin, want: 1},
+		{name: "status match", user: f.alice, filter: ContainerFilter{Status: &removed}, want: 1},
+		{name: "status mismatch", user: f.alice, filter: ContainerFilter{Status: &up}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, total, err := f.containers.ListContainers(context.Background(), tt.user, tt.filter, 0, 10)
+			if err != nil {
+				t.Fatalf("ListContainers() error = %v", err)
+			}
+			if total != tt.want || len(items) != tt.want {
+				t.Errorf("ListContainers() = %d items, total %d, want %d", len(items), total, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+	"liteide-backend/config"
+	"liteide-backend/ent"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/user"
+	"liteide-backend/ent/workspace"
+	"liteide-backend/repository/db"
+	"liteide-backend/svc"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// minPasswordLength 密码的最小长度
+const minPasswordLength = 8
+
+// tokenIssuer 访问令牌的签发者
+const tokenIssuer = "liteide"
+
+// dummyPasswordHash 用户不存在时参与比较的密码哈希，使登录耗时与用户是否存在无关
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, err := bcrypt.GenerateFromPassword([]byte("liteide-dummy-password"), bcrypt.DefaultCost)
+	if err != nil {
+		panic(err)
+	}
+	return hash
+})
+
+// AuthService 用户登录、访问令牌与用户管理
+type AuthService struct {
+	database *ent.Client      // 数据库客户端
+	conf     config.AppConfig // 应用配置，使用其中的认证配置
+}
+
+// NewAuthService 使用服务上下文创建认证服务
+func NewAuthService(sc *svc.ServiceContext) *AuthService {
+	return &AuthService{database: sc.Database, conf: sc.AppConfig}
+}
+
+// Token 登录后签发的访问令牌
+type Token struct {
+	Token      string    // JWT 字符串
+	ExpireTime time.Time // 过期时间
+}
+
+// Login 校验用户名与密码并签发访问令牌
+// - `ctx`：请求的上下文
+// - `username`：用户名
+// - `password`：明文密码
+// - 用户不存在或密码错误时统一返回 ErrInvalidCredentials，避免泄露用户是否存在
+// - 用户不存在时仍与 dummyPasswordHash 比较一次，避免通过响应耗时枚举用户名
+func (s *AuthService) Login(ctx context.Context, username string, password string) (*Token, *ent.User, error) {
+	userInstance, err := s.database.User.Query().
+		Where(user.Username(username)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+		return nil, nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(userInstance.PasswordHash), []byte(password)); err != nil {
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	token, err := s.issueToken(userInstance)
+	if err != nil {
+		return nil, nil, err
+	}
+	return token, userInstance, nil
+}
+
+// Authenticate 校验访问令牌并返回对应的用户
+// - `ctx`：请求的上下文
+// - `token`：JWT 字符串
+// - 令牌无效、过期或用户已被删除时返回 ErrUnauthenticated
+func (s *AuthService) Authenticate(ctx context.Context, token string) (*ent.User, error) {
+	claims := jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return []byte(s.conf.AuthConfig.JWTSecret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(tokenIssuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid subject", ErrUnauthenticated)
+	}
+
+	// 每次请求都查询用户，使删除用户或变更角色立即生效
+	userInstance, err := s.database.User.Get(ctx, userId)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: user no longer exists", ErrUnauthenticated)
+	}
+	return userInstance, err
+}
+
+// issueToken 为用户签发 HS256 访问令牌，主题为用户 ID
+func (s *AuthService) issueToken(userInstance *ent.User) (*Token, error) {
+	now := time.Now()
+	expireTime := now.Add(s.conf.AuthConfig.TokenTTL)
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Issuer:    tokenIssuer,
+		Subject:   strconv.Itoa(userInstance.ID),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expireTime),
+	}).SignedString([]byte(s.conf.AuthConfig.JWTSecret))
+	if err != nil {
+		return nil, err
+	}
+	return &Token{Token: signed, ExpireTime: expireTime}, nil
+}
+
+// CreateUser 创建用户
+// - `ctx`：请求的上下文
+// - `username`：用户名，格式由 ent 校验
+// - `password`：明文密码，以 bcrypt 哈希保存
+// - `role`：用户角色
+func (s *AuthService) CreateUser(ctx context.Context, username string, password string, role property.UserRole) (*ent.User, error) {
+	if len(password) < minPasswordLength {
+		return nil, fmt.Errorf("%w: password must be at least %d characters", ErrInvalidPassword, minPasswordLength)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPassword, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.database.User.Create().
+		SetUsername(username).
+		SetPasswordHash(string(hash)).
+		SetRole(role).
+		Save(ctx)
+}
+
+// EnsureAdmin 在配置了初始管理员密码且该用户不存在时创建初始管理员
+// - `ctx`：上下文
+func (s *AuthService) EnsureAdmin(ctx context.Context) error {
+	conf := s.conf.AuthConfig
+	if conf.AdminPassword == "" {
+		return nil
+	}
+
+	exists, err := s.database.User.Query().
+		Where(user.Username(conf.AdminUsername)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	if _, err := s.CreateUser(ctx, conf.AdminUsername, conf.AdminPassword, property.UserRoleAdmin); err != nil {
+		return err
+	}
+	log.Infof("admin user created: %s", conf.AdminUsername)
+	return nil
+}
+
+// AssignOwnerlessRecords 将没有所有者的工作区与容器记录归属给最早创建的管理员
+// - `ctx`：上下文
+// - 引入用户之前创建的记录迁移后 user_id 为空，只有管理员可以访问
+// - 尚无管理员时保留这些记录并输出警告，配置 ADMIN_PASSWORD 后重启即可完成归属
+func (s *AuthService) AssignOwnerlessRecords(ctx context.Context) error {
+	return db.WithTx(s.database, ctx, func(client *ent.Client, ctx context.Context) error {
+		workspaces, err := client.Workspace.Query().Where(workspace.UserIDIsNil()).Count(ctx)
+		if err != nil {
+			return err
+		}
+		containers, err := client.Container.Query().Where(container.UserIDIsNil()).Count(ctx)
+		if err != nil {
+			return err
+		}
+		if workspaces == 0 && containers == 0 {
+			return nil
+		}
+
+		admin, err := client.User.Query().
+			Where(user.RoleEQ(property.UserRoleAdmin)).
+			Order(ent.Asc(user.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			log.Warnf("%d workspaces and %d containers have no owner, set ADMIN_PASSWORD to assign them to an admin", workspaces, containers)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := client.Workspace.Update().
+			Where(workspace.UserIDIsNil()).
+			SetUserID(admin.ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := client.Container.Update().
+			Where(container.UserIDIsNil()).
+			SetUserID(admin.ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		log.Infof("assigned %d workspaces and %d containers without owner to admin %s", workspaces, containers, admin.Username)
+		return nil
+	})
+}
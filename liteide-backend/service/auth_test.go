@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"liteide-backend/ent"
+	"liteide-backend/ent/property"
+	"testing"
+)
+
+func TestLogin(t *testing.T) {
+	f := setupService(t)
+	ctx := context.Background()
+	if _, err := f.auth.CreateUser(ctx, "carol", "correct-horse", property.UserRoleUser); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		username string
+		password string
+		want     error
+	}{
+		{name: "success", username: "carol", password: "correct-horse"},
+		{name: "wrong password", username: "carol", password: "battery-staple", want: ErrInvalidCredentials},
+		{name: "unknown user", username: "dave", password: "correct-horse", want: ErrInvalidCredentials},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, userInstance, err := f.auth.Login(ctx, tt.username, tt.password)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Login() error = %v, want %v", err, tt.want)
+			}
+			if tt.want != nil {
+				return
+			}
+			authenticated, err := f.auth.Authenticate(ctx, token.Token)
+			if err != nil || authenticated.ID != userInstance.ID {
+				t.Errorf("Authenticate() = %v, %v, want user %d", authenticated, err, userInstance.ID)
+			}
+		})
+	}
+}
+
+func TestAssignOwnerlessRecords(t *testing.T) {
+	f := setupService(t)
+	ctx := context.Background()
+	database := f.auth.database
+
+	// 模拟引入用户之前创建的记录
+	imageInstance := f.container.QueryImage().OnlyX(ctx)
+	legacyWorkspace := database.Workspace.Create().SetName("legacy").SetLanguage("C").SaveX(ctx)
+	legacyContainer := database.Container.Create().
+		SetWorkspace(legacyWorkspace).
+		SetImage(imageInstance).
+		SetContainerStatus(property.ContainerStatusRemoved).
+		SaveX(ctx)
+
+	// 只有管理员可以访问没有所有者的记录
+	if _, err := f.workspaces.GetWorkspace(ctx, f.alice, legacyWorkspace.ID); !ent.IsNotFound(err) {
+		t.Errorf("alice GetWorkspace() error = %v, want not found", err)
+	}
+	if _, err := f.workspaces.GetWorkspace(ctx, f.admin, legacyWorkspace.ID); err != nil {
+		t.Errorf("admin GetWorkspace() error = %v", err)
+	}
+
+	if err := f.auth.AssignOwnerlessRecords(ctx); err != nil {
+		t.Fatalf("AssignOwnerlessRecords() error = %v", err)
+	}
+	if got := database.Workspace.GetX(ctx, legacyWorkspace.ID).UserID; got != f.admin.ID {
+		t.Errorf("legacy workspace owner = %d, want %d", got, f.admin.ID)
+	}
+	if got := database.Container.GetX(ctx, legacyContainer.ID).UserID; got != f.admin.ID {
+		t.Errorf("legacy container owner = %d, want %d", got, f.admin.ID)
+	}
+	if got := database.Workspace.GetX(ctx, f.workspace.ID).UserID; got != f.alice.ID {
+		t.Errorf("alice workspace owner = %d, want %d", got, f.alice.ID)
+	}
+	if got := database.Container.GetX(ctx, f.container.ID).UserID; got != f.alice.ID {
+		t.Errorf("alice container owner = %d, want %d", got, f.alice.ID)
+	}
+}
+
+func TestAssignOwnerlessRecordsWithoutAdmin(t *testing.T) {
+	f := setupService(t)
+	ctx := context.Background()
+	database := f.auth.database
+	database.User.DeleteOne(f.admin).ExecX(ctx)
+	legacyWorkspace := database.Workspace.Create().SetName("legacy").SetLanguage("C").SaveX(ctx)
+
+	// 没有管理员时保留记录，待配置管理员后再归属
+	if err := f.auth.AssignOwnerlessRecords(ctx); err != nil {
+		t.Fatalf("AssignOwnerlessRecords() error = %v", err)
+	}
+	if got := database.Workspace.GetX(ctx, legacyWorkspace.ID).UserID; got != 0 {
+		t.Errorf("legacy workspace owner = %d, want none", got)
+	}
+}
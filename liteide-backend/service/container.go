@@ -2,199 +2,300 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"github.com/docker/docker/api/types"
-	"github.com/docker/docker/api/types/filters"
-	"github.com/docker/docker/api/types/mount"
-	"github.com/docker/docker/api/types/swarm"
 	"github.com/gofiber/fiber/v2/log"
+	"liteide-backend/config"
+	"liteide-backend/ent"
 	"liteide-backend/ent/image"
 	"liteide-backend/ent/property"
+	"liteide-backend/repository/docker"
+	"liteide-backend/repository/model"
 	"liteide-backend/svc"
-	"path"
 	"strconv"
 	"time"
 )
 
+// ContainerService 容器的创建、删除、附加终端、运行程序，以及对账与空闲回收
+type ContainerService struct {
+	database   *ent.Client             // 数据库客户端
+	runtime    docker.ContainerRuntime // 容器运行时
+	conf       config.AppConfig        // 应用配置
+	languages  *model.Registry         // 语言工具链注册表
+	workspaces *WorkspaceService       // 工作区服务，提供工作区访问检查、工作区目录与配额检查
+	activity   *activityTracker        // 容器活动跟踪器
+}
+
+// NewContainerService 使用服务上下文创建容器服务
+// - `workspaces`：工作区服务，与工作区创建共享配额锁
+func NewContainerService(sc *svc.ServiceContext, workspaces *WorkspaceService) *ContainerService {
+	return &ContainerService{
+		database:   sc.Database,
+		runtime:    sc.Runtime,
+		conf:       sc.AppConfig,
+		languages:  sc.Languages,
+		workspaces: workspaces,
+		activity:   newActivityTracker(),
+	}
+}
+
 // CreateContainer 创建一个新的 Docker 容器
 // - `ctx`：请求的上下文
-// - `userId`：创建容器的用户 ID
-// - `workspaceId`：关联的工作区 ID
+// - `user`：当前用户，容器属于该用户
+// - `workspaceId`：关联的工作区 ID，当前用户必须有权访问
 // - 返回容器 ID 和错误信息（如果有）
-func CreateContainer(ctx context.Context, userId int, workspaceId int) (*int, error) {
+func (s *ContainerService) CreateContainer(ctx context.Context, user *ent.User, workspaceId int) (*int, error) {
 	// 获取工作区信息
-	workspaceInstance, err := svc.SVC.Database.Workspace.Get(ctx, workspaceId)
+	workspaceInstance, err := s.workspaces.getWorkspace(ctx, user, workspaceId)
 	if err != nil {
 		return nil, err
 	}
 
 	// 查询该工作区对应的镜像信息
-	imageInstance, err := svc.SVC.Database.Image.Query().
+	imageInstance, err := s.database.Image.Query().
 		Where(image.Language(workspaceInstance.Language)).
 		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// 在数据库中创建容器记录（状态：Pending）
-	container, err := svc.SVC.Database.Container.Create().
-		SetUserID(userId).
-		SetImage(imageInstance).
-		SetWorkspace(workspaceInstance).
-		SetContainerStatus(property.ContainerStatusPending).
-		Save(ctx)
+	// 计算容器资源限制（镜像配置优先，否则使用全局默认值）
+	resources, err := s.containerResources(imageInstance)
 	if err != nil {
 		return nil, err
 	}
 
-	// 设置 Swarm 任务副本数
-	replicas := uint64(1)
-
-	// 定义 Swarm 服务配置（相当于 Docker Service）
-	serviceSpec := swarm.ServiceSpec{
-		Annotations: swarm.Annotations{
-			Name: svc.SVC.AppConfig.ContainerServicePrefix + strconv.Itoa(container.ID),
-		},
-		TaskTemplate: swarm.TaskSpec{
-			ContainerSpec: &swarm.ContainerSpec{
-				Image: imageInstance.ImageName,
-				TTY:   true,
-				Dir:   "/workspace",
-				Mounts: []mount.Mount{
-					{
-						Type: mount.TypeBind, // 绑定本地目录到容器
-						Source: path.Join(
-							svc.SVC.AppConfig.DataDirectory,
-							"workspace",
-							workspaceInstance.UUID.String(),
-						),
-						Target: "/workspace",
-					},
-				},
-			},
-		},
-		Mode: swarm.ServiceMode{
-			Replicated: &swarm.ReplicatedService{
-				Replicas: &replicas,
-			},
-		},
-	}
-
-	// 创建 Swarm 服务（相当于 Docker 容器）
-	service, err := svc.SVC.Docker.ServiceCreate(ctx, serviceSpec, types.ServiceCreateOptions{})
+	// 计算容器网络（工作区配置优先，其次镜像配置，否则使用全局默认值）
+	network, internal, err := s.containerNetwork(workspaceInstance, imageInstance)
 	if err != nil {
-		// 如果创建失败，则更新数据库状态为 "Removed"
-		if err := svc.SVC.Database.Container.UpdateOne(container).
+		return nil, err
+	}
+
+	// 在数据库中创建容器记录（状态：Pending），检查配额与创建记录在配额锁内完成
+	var container *ent.Container
+	err = s.workspaces.withQuotaLock(func() error {
+		if err := s.workspaces.checkContainerQuota(ctx, user.ID); err != nil {
+			return err
+		}
+		var err error
+		container, err = s.database.Container.Create().
+			SetUserID(user.ID).
+			SetImage(imageInstance).
+			SetWorkspace(workspaceInstance).
+			SetContainerStatus(property.ContainerStatusPending).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 配额检查通过后再创建网络，被配额拒绝的请求不会创建任何运行时资源
+	// 然后通过容器运行时创建容器实例（Swarm 服务或 Docker 容器）
+	var instanceId string
+	err = s.ensureNetwork(ctx, network, internal)
+	if err == nil {
+		instanceId, err = s.runtime.Create(ctx, docker.Spec{
+			Name:         s.conf.ContainerServicePrefix + strconv.Itoa(container.ID),
+			Image:        imageReference(imageInstance),
+			WorkspaceDir: s.workspaces.WorkspaceDirectory(workspaceInstance),
+			Resources:    resources,
+			Network:      network,
+		})
+	}
+	if err != nil {
+		// 如果创建网络或实例失败，则更新数据库状态为 "Removed"
+		if err := s.database.Container.UpdateOne(container).
 			SetContainerStatus(property.ContainerStatusRemoved).
 			Exec(ctx); err != nil {
 			log.Errorf("failed to update container status: %v", err)
 		}
+		return nil, runtimeError(err)
+	}
+
+	// 记录实例 ID，状态保持 Pending 直到实例启动
+	err = s.database.Container.UpdateOne(container).
+		SetContainerID(instanceId).
+		Exec(ctx)
+	if err != nil {
+		// 如果数据库更新失败，删除创建的实例
+		if err := s.runtime.Remove(ctx, instanceId); err != nil {
+			log.Errorf("failed to remove instance: %v", err)
+			_ = s.database.Container.UpdateOne(container).
+				SetContainerStatus(property.ContainerStatusError).
+				Exec(ctx)
+		}
+		return nil, err
+	}
+
+	// 等待实例进入运行状态
+	if err := s.runtime.WaitRunning(ctx, instanceId, s.conf.ContainerStartTimeout); err != nil {
+		err = runtimeError(err)
+		// 请求可能已被取消，清理操作使用不会被取消的上下文
+		cleanupCtx := context.WithoutCancel(ctx)
+		if err := s.runtime.Remove(cleanupCtx, instanceId); err != nil {
+			log.Errorf("failed to remove instance: %v", err)
+		}
+		// 标记为 Error 并记录失败原因
+		if err := s.database.Container.UpdateOne(container).
+			SetContainerStatus(property.ContainerStatusError).
+			SetStatusMessage(err.Error()).
+			SetExitTime(time.Now()).
+			Exec(cleanupCtx); err != nil {
+			log.Errorf("failed to update container status: %v", err)
+		}
 		return nil, err
 	}
 
-	// TODO: 监听容器启动状态
-	err = svc.SVC.Database.Container.UpdateOne(container).
+	// 实例已启动，标记为 Up
+	err = s.database.Container.UpdateOne(container).
 		SetContainerStatus(property.ContainerStatusUp).
-		SetContainerID(service.ID).
 		Exec(ctx)
-
 	if err != nil {
-		// 如果数据库更新失败，删除创建的 Swarm 服务
-		if err := svc.SVC.Docker.ServiceRemove(ctx, service.ID); err != nil {
-			log.Errorf("failed to remove service: %v", err)
-			_ = svc.SVC.Database.Container.UpdateOne(container).
+		// 如果数据库更新失败，删除创建的实例
+		if err := s.runtime.Remove(ctx, instanceId); err != nil {
+			log.Errorf("failed to remove instance: %v", err)
+			_ = s.database.Container.UpdateOne(container).
 				SetContainerStatus(property.ContainerStatusError).
 				Exec(ctx)
 		}
 		return nil, err
 	}
 
-	log.Debugf("service created: %v", service.ID)
+	// 从创建完成开始计算空闲时间
+	s.TouchContainer(container.ID)
+
+	log.Debugf("instance created: %v", instanceId)
 	return &container.ID, nil
 }
 
 // RemoveContainer 删除 Docker 容器
 // - `ctx`：请求的上下文
+// - `user`：当前用户
 // - `containerId`：要删除的容器 ID
 // - 返回错误信息（如果有）
-func RemoveContainer(ctx context.Context, containerId int) error {
+func (s *ContainerService) RemoveContainer(ctx context.Context, user *ent.User, containerId int) error {
+	if _, err := s.getContainer(ctx, user, containerId); err != nil {
+		return err
+	}
+	return s.removeContainer(ctx, containerId, "")
+}
+
+// removeContainer 删除 Docker 容器并记录删除原因
+// - `ctx`：请求的上下文
+// - `containerId`：要删除的容器 ID
+// - `reason`：删除原因，为空时不记录
+func (s *ContainerService) removeContainer(ctx context.Context, containerId int, reason string) error {
 	// 获取容器信息
-	container, err := svc.SVC.Database.Container.Get(ctx, containerId)
+	container, err := s.database.Container.Get(ctx, containerId)
 	if err != nil {
 		return err
 	}
 
 	// 只有状态为 "Up" 且存在 `ContainerID` 的容器才能删除
 	if container.ContainerStatus != property.ContainerStatusUp || container.ContainerID == nil {
-		return fmt.Errorf("container is not running")
+		return ErrContainerNotRunning
 	}
 
-	// 调用 Docker API 删除 Swarm 服务
-	err = svc.SVC.Docker.ServiceRemove(ctx, *container.ContainerID)
+	// 通过容器运行时删除实例
+	err = s.runtime.Remove(ctx, *container.ContainerID)
 	if err != nil {
-		return err
+		return runtimeError(err)
 	}
 
+	// 容器已删除，不再跟踪空闲状态
+	s.forgetActivity(containerId)
+
 	// 更新数据库状态为 "Removed" 并清除 `ContainerID`
-	return svc.SVC.Database.Container.UpdateOne(container).
+	update := s.database.Container.UpdateOne(container).
 		SetContainerStatus(property.ContainerStatusRemoved).
 		ClearContainerID().
-		SetExitTime(time.Now()). // 记录删除时间
-		Exec(ctx)
+		SetExitTime(time.Now()) // 记录删除时间
+	if reason != "" {
+		update.SetStatusMessage(reason)
+	}
+	return update.Exec(ctx)
 }
 
-// AttachContainer 附加到正在运行的 Docker 容器
+// ExecSession 容器内交互式 Exec 进程的会话
+type ExecSession struct {
+	types.HijackedResponse                         // 与 Exec 进程的双向数据流
+	ExecID                 string                  // Exec 进程 ID
+	runtime                docker.ContainerRuntime // Exec 进程所在的容器运行时
+}
+
+// ExitCode 查询 Exec 进程的退出码
 // - `ctx`：请求的上下文
-// - `containerId`：要附加的容器 ID
-// - 返回 WebSocket 连接（HijackedResponse）和错误信息（如果有）
-func AttachContainer(ctx context.Context, containerId int) (*types.HijackedResponse, error) {
-	// 获取容器信息
-	container, err := svc.SVC.Database.Container.Get(ctx, containerId)
+// - 进程仍在运行时返回 ErrExecRunning
+func (s *ExecSession) ExitCode(ctx context.Context) (int, error) {
+	status, err := s.runtime.ExecInspect(ctx, s.ExecID)
 	if err != nil {
-		return nil, err
+		return 0, runtimeError(err)
+	}
+	if status.Running {
+		return 0, ErrExecRunning
 	}
+	return status.ExitCode, nil
+}
 
-	// 只有状态为 "Up" 且存在 `ContainerID` 的容器才能附加
-	if container.ContainerStatus != property.ContainerStatusUp || container.ContainerID == nil {
-		return nil, fmt.Errorf("container is not running")
+// Resize 调整 Exec 进程的 TTY 尺寸
+// - `ctx`：请求的上下文
+// - `cols`：终端列数
+// - `rows`：终端行数
+func (s *ExecSession) Resize(ctx context.Context, cols uint, rows uint) error {
+	return runtimeError(s.runtime.ExecResize(ctx, s.ExecID, cols, rows))
+}
+
+// AttachContainer 附加到正在运行的 Docker 容器
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `containerId`：要附加的容器 ID
+// - 返回 Exec 会话（包含 HijackedResponse）和错误信息（如果有）
+func (s *ContainerService) AttachContainer(ctx context.Context, user *ent.User, containerId int) (*ExecSession, error) {
+	if _, err := s.getContainer(ctx, user, containerId); err != nil {
+		return nil, err
 	}
 
-	// 在 Docker Swarm 中查找容器实例
-	instanceList, err := svc.SVC.Docker.ContainerList(ctx, types.ContainerListOptions{
-		Filters: func() filters.Args {
-			filterArgs := filters.NewArgs()
-			filterName := svc.SVC.AppConfig.ContainerServicePrefix + strconv.Itoa(container.ID)
-			filterArgs.Add("name", filterName)
-			return filterArgs
-		}(),
-	})
+	// 查找运行中的容器实例
+	instanceId, err := s.runningInstance(ctx, containerId)
 	if err != nil {
 		return nil, err
 	}
-	if len(instanceList) == 0 {
-		return nil, fmt.Errorf("no container found")
-	}
 
-	// 获取找到的第一个容器实例
-	instance := instanceList[0]
-	log.Debugf("instance: %v", instance)
+	// 附加终端视为一次活动
+	s.TouchContainer(containerId)
 
-	// 创建 Docker Exec 进程（进入容器 /bin/sh）
-	execConfig, err := svc.SVC.Docker.ContainerExecCreate(ctx, instance.ID, types.ExecConfig{
-		AttachStdin:  true,                // 允许输入
-		AttachStdout: true,                // 允许输出
-		AttachStderr: true,                // 允许错误输出
-		Cmd:          []string{"/bin/sh"}, // 运行 `/bin/sh`
-		Tty:          true,                // 启用 TTY 模式
+	// 创建 Exec 进程（进入容器 /bin/sh）
+	execId, err := s.runtime.ExecCreate(ctx, instanceId, docker.ExecConfig{
+		Cmd:         []string{"/bin/sh"}, // 运行 `/bin/sh`
+		Tty:         true,                // 启用 TTY 模式
+		AttachStdin: true,                // 允许输入
 	})
 	if err != nil {
-		return nil, err
+		return nil, runtimeError(err)
 	}
 
 	// 附加到 Exec 进程，建立 WebSocket 连接
-	conn, err := svc.SVC.Docker.ContainerExecAttach(ctx, execConfig.ID, types.ExecStartCheck{Detach: false, Tty: true})
+	conn, err := s.runtime.ExecAttach(ctx, execId, true)
 	if err != nil {
-		return nil, err
+		return nil, runtimeError(err)
+	}
+	return &ExecSession{HijackedResponse: conn, ExecID: execId, runtime: s.runtime}, nil
+}
+
+// runningInstance 查询容器记录对应的运行时实例 ID
+// - `ctx`：请求的上下文
+// - `containerId`：容器记录 ID
+// - 容器不处于 Up 状态时返回 ErrContainerNotRunning
+func (s *ContainerService) runningInstance(ctx context.Context, containerId int) (string, error) {
+	// 获取容器信息
+	container, err := s.database.Container.Get(ctx, containerId)
+	if err != nil {
+		return "", err
+	}
+
+	// 只有状态为 "Up" 且存在 `ContainerID` 的容器才能附加
+	if container.ContainerStatus != property.ContainerStatusUp || container.ContainerID == nil {
+		return "", ErrContainerNotRunning
 	}
-	return &conn, nil
+	return *container.ContainerID, nil
 }
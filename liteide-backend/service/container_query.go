@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2/log"
+	"liteide-backend/ent"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/workspace"
+	"liteide-backend/repository/docker"
+)
+
+// ContainerFilter 查询容器列表的过滤条件，为 nil 的条件不生效
+type ContainerFilter struct {
+	Status      *property.ContainerStatus // 容器状态
+	WorkspaceID *int                      // 工作区 ID
+	Language    *property.Language        // 镜像语言
+}
+
+// ContainerDetail 容器详情
+type ContainerDetail struct {
+	*ent.Container               // 容器记录，已加载镜像与工作区
+	Task           *docker.State // 容器实例的实时状态，实例不存在或查询失败时为 nil
+}
+
+// ListContainers 分页查询容器
+// - `ctx`：请求的上下文
+// - `user`：当前用户，管理员可以查询所有容器，其他用户只能查询自己的容器
+// - `filter`：过滤条件
+// - `offset`、`limit`：分页参数
+// - 返回当前页的容器（已加载镜像与工作区）和符合条件的容器总数
+func (s *ContainerService) ListContainers(ctx context.Context, user *ent.User, filter ContainerFilter, offset int, limit int) ([]*ent.Container, int, error) {
+	query, err := visibleContainers(s.database, user)
+	if err != nil {
+		return nil, 0, err
+	}
+	if filter.Status != nil {
+		query.Where(container.ContainerStatusEQ(*filter.Status))
+	}
+	if filter.WorkspaceID != nil {
+		query.Where(container.HasWorkspaceWith(workspace.ID(*filter.WorkspaceID)))
+	}
+	if filter.Language != nil {
+		query.Where(container.HasImageWith(image.Language(*filter.Language)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	containers, err := query.
+		WithImage().
+		WithWorkspace().
+		Order(ent.Desc(container.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return containers, total, nil
+}
+
+// GetContainer 查询容器详情，并合并容器实例的实时状态
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `containerId`：容器记录 ID
+// - 查询运行中的容器视为一次活动
+func (s *ContainerService) GetContainer(ctx context.Context, user *ent.User, containerId int) (*ContainerDetail, error) {
+	if _, err := s.getContainer(ctx, user, containerId); err != nil {
+		return nil, err
+	}
+
+	containerInstance, err := s.database.Container.Query().
+		Where(container.ID(containerId)).
+		WithImage().
+		WithWorkspace().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if containerInstance.ContainerStatus == property.ContainerStatusUp {
+		s.TouchContainer(containerId)
+	}
+
+	detail := &ContainerDetail{Container: containerInstance}
+	if containerInstance.ContainerID != nil {
+		// 实时状态只是补充信息，容器运行时不可用时仍返回数据库中的记录
+		detail.Task, err = s.runtime.Inspect(ctx, *containerInstance.ContainerID)
+		if err != nil {
+			log.Warnf("failed to query state of container %d: %v", containerId, err)
+		}
+	}
+	return detail, nil
+}
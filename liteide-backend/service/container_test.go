@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"liteide-backend/ent"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/docker"
+	"strings"
+	"testing"
+	"time"
+)
+
+// errBoom 注入到内存运行时的通用错误
+var errBoom = errors.New("boom")
+
+// latestContainer 查询最近创建的容器记录
+func latestContainer(t *testing.T, f fixture) *ent.Container {
+	t.Helper()
+	record, err := f.container.QueryWorkspace().QueryContainers().
+		Order(ent.Desc(container.FieldID)).
+		First(context.Background())
+	if err != nil {
+		t.Fatalf("query container: %v", err)
+	}
+	return record
+}
+
+// createUpContainer 为 alice 创建一个运行中的容器
+func createUpContainer(t *testing.T, f fixture) *ent.Container {
+	t.Helper()
+	id, err := f.containers.CreateContainer(context.Background(), f.alice, f.workspace.ID)
+	if err != nil {
+		t.Fatalf("CreateContainer() error = %v", err)
+	}
+	record, err := f.container.QueryWorkspace().QueryContainers().Where(container.ID(*id)).Only(context.Background())
+	if err != nil {
+		t.Fatalf("query container: %v", err)
+	}
+	return record
+}
+
+func TestCreateContainer(t *testing.T) {
+	tests := []struct {
+		name      string
+		op        string // 注入失败的运行时操作，为空时不注入
+		fail      error
+		want      error
+		status    property.ContainerStatus
+		instances int // 创建结束后运行时中的实例数
+	}{
+		{name: "success", status: property.ContainerStatusUp, instances: 1},
+		{name: "create fails", op: docker.FakeOpCreate, fail: errBoom, want: ErrDocker, status: property.ContainerStatusRemoved},
+		{name: "start fails", op: docker.FakeOpWaitRunning, fail: fmt.Errorf("%w: task rejected", docker.ErrStartFailed),
+			want: ErrContainerStartFailed, status: property.ContainerStatusError},
+		{name: "start times out", op: docker.FakeOpWaitRunning, fail: context.DeadlineExceeded,
+			want: ErrDocker, status: property.ContainerStatusError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			if tt.op != "" {
+				f.runtime.FailOn(tt.op, tt.fail)
+			}
+
+			_, err := f.containers.CreateContainer(context.Background(), f.alice, f.workspace.ID)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("CreateContainer() error = %v, want %v", err, tt.want)
+			}
+
+			record := latestContainer(t, f)
+			if record.ContainerStatus != tt.status {
+				t.Errorf("status = %s, want %s", record.ContainerStatus, tt.status)
+			}
+			if tt.status == property.ContainerStatusError && (record.StatusMessage == nil || record.ExitTime == nil) {
+				t.Errorf("failed container should record message and exit time, got %+v", record)
+			}
+
+			instances, _ := f.runtime.List(context.Background(), "")
+			if len(instances) != tt.instances {
+				t.Errorf("runtime has %d instances, want %d", len(instances), tt.instances)
+			}
+			if tt.want != nil {
+				return
+			}
+
+			// 实例使用工作区目录与语言镜像，并以记录 ID 命名
+			spec, ok := f.runtime.Spec(*record.ContainerID)
+			if !ok {
+				t.Fatalf("instance %s not found", *record.ContainerID)
+			}
+			if want := fmt.Sprintf("liteide-pod-%d", record.ID); spec.Name != want {
+				t.Errorf("instance name = %s, want %s", spec.Name, want)
+			}
+			if spec.Image != "gcc:13" || spec.WorkspaceDir != f.workspaces.WorkspaceDirectory(f.workspace) || spec.Network != docker.NetworkNone {
+				t.Errorf("unexpected spec %+v", spec)
+			}
+		})
+	}
+}
+
+func TestCreateContainerWaitRunning(t *testing.T) {
+	tests := []struct {
+		name    string
+		delay   time.Duration // 实例的启动时间
+		timeout time.Duration // 等待实例启动的超时时间
+		want    error
+		status  property.ContainerStatus
+		message string // 失败原因应包含的内容
+	}{
+		{name: "starts in time", delay: 50 * time.Millisecond, timeout: time.Second, status: property.ContainerStatusUp},
+		{name: "times out", delay: time.Hour, timeout: 100 * time.Millisecond,
+			want: ErrContainerStartFailed, status: property.ContainerStatusError, message: "timed out"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			ctx := context.Background()
+			f.containers.conf.ContainerStartTimeout = tt.timeout
+			f.runtime.SetStartDelay(tt.delay)
+
+			// 等待实例启动期间记录保持 Pending
+			done := make(chan error, 1)
+			go func() {
+				_, err := f.containers.CreateContainer(ctx, f.alice, f.workspace.ID)
+				done <- err
+			}()
+			time.Sleep(20 * time.Millisecond)
+			if record := latestContainer(t, f); record.ContainerStatus != property.ContainerStatusPending {
+				t.Errorf("status while starting = %s, want %s", record.ContainerStatus, property.ContainerStatusPending)
+			}
+
+			if err := <-done; !errors.Is(err, tt.want) {
+				t.Fatalf("CreateContainer() error = %v, want %v", err, tt.want)
+			}
+			record := latestContainer(t, f)
+			if record.ContainerStatus != tt.status {
+				t.Errorf("status = %s, want %s", record.ContainerStatus, tt.status)
+			}
+			if tt.message != "" && (record.StatusMessage == nil || !strings.Contains(*record.StatusMessage, tt.message)) {
+				t.Errorf("status message = %v, want containing %q", record.StatusMessage, tt.message)
+			}
+			if tt.want != nil && record.ExitTime == nil {
+				t.Error("failed container should record exit time")
+			}
+
+			// 启动失败的实例被删除
+			instances, _ := f.runtime.List(ctx, "")
+			if want := map[bool]int{true: 0, false: 1}[tt.want != nil]; len(instances) != want {
+				t.Errorf("runtime has %d instances, want %d", len(instances), want)
+			}
+		})
+	}
+}
+
+func TestRemoveContainer(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(f fixture, record *ent.Container)
+		want    error
+		status  property.ContainerStatus
+	}{
+		{name: "success", status: property.ContainerStatusRemoved},
+		{name: "runtime fails", prepare: func(f fixture, _ *ent.Container) {
+			f.runtime.FailOn(docker.FakeOpRemove, errBoom)
+		}, want: ErrDocker, status: property.ContainerStatusUp},
+		{name: "instance gone", prepare: func(f fixture, record *ent.Container) {
+			_ = f.runtime.Remove(context.Background(), *record.ContainerID)
+		}, want: ErrInstanceNotFound, status: property.ContainerStatusUp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			record := createUpContainer(t, f)
+			if tt.prepare != nil {
+				tt.prepare(f, record)
+			}
+
+			if err := f.containers.RemoveContainer(context.Background(), f.alice, record.ID); !errors.Is(err, tt.want) {
+				t.Fatalf("RemoveContainer() error = %v, want %v", err, tt.want)
+			}
+
+			record = latestContainer(t, f)
+			if record.ContainerStatus != tt.status {
+				t.Errorf("status = %s, want %s", record.ContainerStatus, tt.status)
+			}
+			if tt.want == nil {
+				if record.ContainerID != nil || record.ExitTime == nil {
+					t.Errorf("removed container should clear instance ID and record exit time, got %+v", record)
+				}
+				if err := f.containers.RemoveContainer(context.Background(), f.alice, record.ID); !errors.Is(err, ErrContainerNotRunning) {
+					t.Errorf("second RemoveContainer() error = %v, want %v", err, ErrContainerNotRunning)
+				}
+			}
+		})
+	}
+}
+
+func TestAttachContainer(t *testing.T) {
+	t.Run("shell echo", func(t *testing.T) {
+		f := setupService(t)
+		record := createUpContainer(t, f)
+		ctx := context.Background()
+
+		session, err := f.containers.AttachContainer(ctx, f.alice, record.ID)
+		if err != nil {
+			t.Fatalf("AttachContainer() error = %v", err)
+		}
+		defer session.Close()
+
+		if _, err := session.Conn.Write([]byte("echo hello\nexit 3\n")); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		line, err := session.Reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		if strings.TrimSpace(line) != "hello" {
+			t.Errorf("output = %q, want %q", line, "hello")
+		}
+		if err := session.Resize(ctx, 80, 24); err != nil {
+			t.Errorf("Resize() error = %v", err)
+		}
+
+		// 读取到连接关闭即 shell 已退出
+		_, _ = io.Copy(io.Discard, session.Reader)
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			code, err := session.ExitCode(ctx)
+			if errors.Is(err, ErrExecRunning) && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			if err != nil || code != 3 {
+				t.Errorf("ExitCode() = %d, %v, want 3", code, err)
+			}
+			break
+		}
+	})
+
+	tests := []struct {
+		name    string
+		prepare func(f fixture, record *ent.Container)
+		want    error
+	}{
+		{name: "instance stopped", prepare: func(f fixture, record *ent.Container) {
+			f.runtime.Stop(*record.ContainerID, "exit code 1")
+		}, want: ErrInstanceNotFound},
+		{name: "exec fails", prepare: func(f fixture, _ *ent.Container) {
+			f.runtime.FailOn(docker.FakeOpExecCreate, errBoom)
+		}, want: ErrDocker},
+		{name: "attach fails", prepare: func(f fixture, _ *ent.Container) {
+			f.runtime.FailOn(docker.FakeOpExecAttach, errBoom)
+		}, want: ErrDocker},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			record := createUpContainer(t, f)
+			tt.prepare(f, record)
+
+			if _, err := f.containers.AttachContainer(context.Background(), f.alice, record.ID); !errors.Is(err, tt.want) {
+				t.Errorf("AttachContainer() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"liteide-backend/repository/docker"
+)
+
+// 服务层对外暴露的错误类型，控制器层据此映射 HTTP 状态码
+var (
+	ErrContainerNotRunning  = errors.New("container is not running")     // 容器未处于运行状态
+	ErrInstanceNotFound     = docker.ErrNotFound                         // 容器运行时中找不到容器实例
+	ErrContainerStartFailed = docker.ErrStartFailed                      // 容器实例未能进入运行状态
+	ErrInvalidResources     = errors.New("invalid resource limits")      // 镜像的资源限制配置不合法
+	ErrExecRunning          = errors.New("exec is still running")        // Exec 进程尚未退出
+	ErrWorkspaceInUse       = errors.New("workspace is in use")          // 工作区仍有运行中的容器
+	ErrInvalidPath          = errors.New("invalid path")                 // 文件路径不合法或超出工作区
+	ErrFileTooLarge         = errors.New("file too large")               // 文件超过 API 读取上限
+	ErrInvalidRun           = errors.New("invalid run request")          // 运行参数不合法
+	ErrUnknownLanguage      = errors.New("unknown language")             // 语言不在工具链注册表中
+	ErrInvalidImage         = errors.New("invalid image")                // 镜像参数不合法
+	ErrImageInUse           = errors.New("image is in use")              // 镜像仍被容器记录引用
+	ErrInvalidCredentials   = errors.New("invalid username or password") // 登录凭据错误
+	ErrUnauthenticated      = errors.New("unauthenticated")              // 缺少访问令牌或令牌无效
+	ErrForbidden            = errors.New("permission denied")            // 角色权限不足，例如非管理员访问管理接口
+	ErrQuotaExceeded        = errors.New("quota exceeded")               // 超出用户配额
+	ErrInvalidPassword      = errors.New("invalid password")             // 密码不满足要求
+	ErrDocker               = errors.New("docker failure")               // 容器运行时调用失败
+)
+
+// runtimeError 将容器运行时的错误映射为服务层错误
+// - 实例不存在与启动失败原样返回，其余错误包装为 ErrDocker
+func runtimeError(err error) error {
+	if err == nil || errors.Is(err, ErrInstanceNotFound) || errors.Is(err, ErrContainerStartFailed) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", ErrDocker, err)
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"liteide-backend/ent"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxFileSize 通过 API 读取的文件大小上限
+const maxFileSize = 10 << 20
+
+// FileInfo 工作区内文件或目录的信息
+type FileInfo struct {
+	Name    string    // 文件名
+	Path    string    // 相对工作区根目录的路径，使用 / 分隔
+	IsDir   bool      // 是否为目录
+	Size    int64     // 文件大小（字节）
+	ModTime time.Time // 最后修改时间
+}
+
+// cleanWorkspacePath 将客户端传入的路径规范化为相对工作区根目录的路径
+// - 以 / 开头或为空均视为相对工作区根目录
+// - 规范化后仍指向根目录之外（包含 ..）或包含 NUL 字符时返回 ErrInvalidPath
+// - 符号链接逃逸由 os.Root 在访问时拦截
+func cleanWorkspacePath(p string) (string, error) {
+	if strings.ContainsRune(p, 0) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPath, p)
+	}
+	cleaned := path.Clean("/" + p)[1:]
+	if cleaned == "" {
+		return ".", nil
+	}
+	if !fs.ValidPath(cleaned) || slices.Contains(strings.Split(cleaned, "/"), "..") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPath, p)
+	}
+	return cleaned, nil
+}
+
+// cleanWorkspaceEntry 规范化路径，且不允许指向工作区根目录本身
+func cleanWorkspaceEntry(p string) (string, error) {
+	cleaned, err := cleanWorkspacePath(p)
+	if err != nil {
+		return "", err
+	}
+	if cleaned == "." {
+		return "", fmt.Errorf("%w: workspace root cannot be modified", ErrInvalidPath)
+	}
+	return cleaned, nil
+}
+
+// openWorkspaceRoot 打开工作区目录，所有文件操作都限制在该目录内
+// - 当前用户必须有权访问该工作区
+func (s *WorkspaceService) openWorkspaceRoot(ctx context.Context, user *ent.User, workspaceId int) (*os.Root, error) {
+	workspaceInstance, err := s.getWorkspace(ctx, user, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return s.openRootOf(workspaceInstance)
+}
+
+// openRootOf 打开指定工作区实体的目录
+func (s *WorkspaceService) openRootOf(workspaceInstance *ent.Workspace) (*os.Root, error) {
+	return os.OpenRoot(s.WorkspaceDirectory(workspaceInstance))
+}
+
+// ListFiles 列出工作区内目录的直接子项
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - `dir`：目录路径，空字符串表示工作区根目录
+func (s *WorkspaceService) ListFiles(ctx context.Context, user *ent.User, workspaceId int, dir string) ([]FileInfo, error) {
+	dir, err := cleanWorkspacePath(dir)
+	if err != nil {
+		return nil, err
+	}
+	root, err := s.openWorkspaceRoot(ctx, user, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	defer root.Close()
+
+	entries, err := fs.ReadDir(root.FS(), dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			continue // 读取过程中被删除
+		}
+		files = append(files, FileInfo{
+			Name:    entry.Name(),
+			Path:    path.Join(dir, entry.Name()),
+			IsDir:   entry.IsDir(),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+	}
+	return files, nil
+}
+
+// ReadFile 读取工作区内文件的内容
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - `p`：文件路径
+// - 超过 maxFileSize 时返回 ErrFileTooLarge
+func (s *WorkspaceService) ReadFile(ctx context.Context, user *ent.User, workspaceId int, p string) ([]byte, error) {
+	p, err := cleanWorkspaceEntry(p)
+	if err != nil {
+		return nil, err
+	}
+	root, err := s.openWorkspaceRoot(ctx, user, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	defer root.Close()
+
+	info, err := root.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%w: %s is a directory", ErrInvalidPath, p)
+	}
+	if info.Size() > maxFileSize {
+		return nil, ErrFileTooLarge
+	}
+	return root.ReadFile(p)
+}
+
+// WriteFile 创建或覆盖工作区内的文件（父目录必须存在）
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - `p`：文件路径
+// - `data`：文件内容
+// - 超出工作区所有者的磁盘配额时返回 ErrQuotaExceeded
+func (s *WorkspaceService) WriteFile(ctx context.Context, user *ent.User, workspaceId int, p string, data []byte) error {
+	p, err := cleanWorkspaceEntry(p)
+	if err != nil {
+		return err
+	}
+	workspaceInstance, err := s.getWorkspace(ctx, user, workspaceId)
+	if err != nil {
+		return err
+	}
+	root, err := s.openRootOf(workspaceInstance)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	// 覆盖文件时只计算大小的增量
+	delta := int64(len(data))
+	if info, err := root.Stat(p); err == nil && info.Mode().IsRegular() {
+		delta -= info.Size()
+	}
+	if err := s.checkDiskQuota(ctx, workspaceInstance.UserID, delta); err != nil {
+		return err
+	}
+
+	return root.WriteFile(p, data, 0o644)
+}
+
+// CreateFile 在工作区内创建空文件或目录（已存在时返回 fs.ErrExist）
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - `p`：文件或目录路径
+// - `isDir`：是否创建目录
+func (s *WorkspaceService) CreateFile(ctx context.Context, user *ent.User, workspaceId int, p string, isDir bool) error {
+	p, err := cleanWorkspaceEntry(p)
+	if err != nil {
+		return err
+	}
+	workspaceInstance, err := s.getWorkspace(ctx, user, workspaceId)
+	if err != nil {
+		return err
+	}
+
+	// 已超出磁盘配额时不再允许创建新文件
+	if err := s.checkDiskQuota(ctx, workspaceInstance.UserID, 0); err != nil {
+		return err
+	}
+
+	root, err := s.openRootOf(workspaceInstance)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	if isDir {
+		return root.Mkdir(p, 0o755)
+	}
+	file, err := root.OpenFile(p, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// MoveFile 重命名或移动工作区内的文件或目录（目标已存在时返回 fs.ErrExist）
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - `from`：源路径
+// - `to`：目标路径
+func (s *WorkspaceService) MoveFile(ctx context.Context, user *ent.User, workspaceId int, from string, to string) error {
+	from, err := cleanWorkspaceEntry(from)
+	if err != nil {
+		return err
+	}
+	to, err = cleanWorkspaceEntry(to)
+	if err != nil {
+		return err
+	}
+	// 不能将目录移动到其自身内部
+	if strings.HasPrefix(to+"/", from+"/") {
+		return fmt.Errorf("%w: cannot move %s into itself", ErrInvalidPath, from)
+	}
+
+	root, err := s.openWorkspaceRoot(ctx, user, workspaceId)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	// 源路径必须存在，目标路径不能存在（os.Rename 会覆盖文件）
+	if _, err := root.Lstat(from); err != nil {
+		return err
+	}
+	if _, err := root.Lstat(to); err == nil {
+		return &fs.PathError{Op: "rename", Path: to, Err: fs.ErrExist}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return root.Rename(from, to)
+}
+
+// DeleteFile 删除工作区内的文件或目录（目录递归删除）
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - `p`：文件或目录路径
+func (s *WorkspaceService) DeleteFile(ctx context.Context, user *ent.User, workspaceId int, p string) error {
+	p, err := cleanWorkspaceEntry(p)
+	if err != nil {
+		return err
+	}
+	root, err := s.openWorkspaceRoot(ctx, user, workspaceId)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	// RemoveAll 对不存在的路径不报错，先确认存在
+	if _, err := root.Lstat(p); err != nil {
+		return err
+	}
+	return root.RemoveAll(p)
+}
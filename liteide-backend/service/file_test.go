@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCleanWorkspacePath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+		err  error
+	}{
+		{name: "empty", path: "", want: "."},
+		{name: "root", path: "/", want: "."},
+		{name: "relative", path: "src/main.c", want: "src/main.c"},
+		{name: "absolute mapped under root", path: "/etc/passwd", want: "etc/passwd"},
+		{name: "dot segments", path: "./src/../main.c", want: "main.c"},
+		{name: "parent", path: "../x", want: "x"},
+		{name: "parent after segment", path: "a/../../x", want: "x"},
+		{name: "embedded nul", path: "main.c\x00.txt", err: ErrInvalidPath},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cleanWorkspacePath(tt.path)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("cleanWorkspacePath(%q) error = %v, want %v", tt.path, err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("cleanWorkspacePath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileTraversal(t *testing.T) {
+	f := setupService(t)
+	ctx := context.Background()
+	dir := f.workspaces.WorkspaceDirectory(f.workspace)
+
+	// 工作区外的目录与文件，通过工作区内的符号链接指向它们
+	outside := t.TempDir()
+	secret := filepath.Join(outside, "secret")
+	if err := os.WriteFile(secret, []byte("secret"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(dir, "escape")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(secret, filepath.Join(dir, "secret-link")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.c"), []byte("int main() {}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "src"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	ops := []struct {
+		name string
+		op   func() error
+		err  error // 期望的错误，为 nil 时只要求返回错误
+	}{
+		{name: "read through symlink dir", op: func() error {
+			_, err := f.workspaces.ReadFile(ctx, f.alice, f.workspace.ID, "escape/secret")
+			return err
+		}},
+		{name: "read symlink to file", op: func() error {
+			_, err := f.workspaces.ReadFile(ctx, f.alice, f.workspace.ID, "secret-link")
+			return err
+		}},
+		{name: "list through symlink", op: func() error {
+			_, err := f.workspaces.ListFiles(ctx, f.alice, f.workspace.ID, "escape")
+			return err
+		}},
+		{name: "write through symlink", op: func() error {
+			return f.workspaces.WriteFile(ctx, f.alice, f.workspace.ID, "escape/new", []byte("x"))
+		}},
+		{name: "overwrite symlink target", op: func() error {
+			return f.workspaces.WriteFile(ctx, f.alice, f.workspace.ID, "secret-link", []byte("x"))
+		}},
+		{name: "create through symlink", op: func() error {
+			return f.workspaces.CreateFile(ctx, f.alice, f.workspace.ID, "escape/new", false)
+		}},
+		{name: "delete through symlink", op: func() error {
+			return f.workspaces.DeleteFile(ctx, f.alice, f.workspace.ID, "escape/secret")
+		}},
+		{name: "move out through symlink", op: func() error {
+			return f.workspaces.MoveFile(ctx, f.alice, f.workspace.ID, "main.c", "escape/main.c")
+		}},
+		{name: "nul in path", err: ErrInvalidPath, op: func() error {
+			_, err := f.workspaces.ReadFile(ctx, f.alice, f.workspace.ID, "main.c\x00")
+			return err
+		}},
+		{name: "write root", err: ErrInvalidPath, op: func() error {
+			return f.workspaces.WriteFile(ctx, f.alice, f.workspace.ID, "/", []byte("x"))
+		}},
+		{name: "delete root", err: ErrInvalidPath, op: func() error {
+			return f.workspaces.DeleteFile(ctx, f.alice, f.workspace.ID, "")
+		}},
+		{name: "delete root via parent", err: ErrInvalidPath, op: func() error {
+			return f.workspaces.DeleteFile(ctx, f.alice, f.workspace.ID, "src/..")
+		}},
+		{name: "move root", err: ErrInvalidPath, op: func() error {
+			return f.workspaces.MoveFile(ctx, f.alice, f.workspace.ID, "/", "src/root")
+		}},
+		{name: "move dir into itself", err: ErrInvalidPath, op: func() error {
+			return f.workspaces.MoveFile(ctx, f.alice, f.workspace.ID, "src", "src/inner")
+		}},
+		{name: "move dir onto itself", err: ErrInvalidPath, op: func() error {
+			return f.workspaces.MoveFile(ctx, f.alice, f.workspace.ID, "src", "./src/")
+		}},
+	}
+	for _, tt := range ops {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.op()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	// 工作区外的目录与文件保持不变
+	entries, err := os.ReadDir(outside)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "secret" {
+		t.Errorf("outside directory = %v, want only secret", entries)
+	}
+	if data, _ := os.ReadFile(secret); string(data) != "secret" {
+		t.Errorf("outside file = %q, want unchanged", data)
+	}
+
+	// 路径中的 .. 与开头的 / 被限制在工作区内
+	if err := f.workspaces.WriteFile(ctx, f.alice, f.workspace.ID, "../../x", []byte("x")); err != nil {
+		t.Fatalf("WriteFile(../../x) error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "x")); err != nil {
+		t.Errorf("../../x was not written under the workspace: %v", err)
+	}
+	data, err := f.workspaces.ReadFile(ctx, f.alice, f.workspace.ID, "/main.c")
+	if err != nil || string(data) != "int main() {}" {
+		t.Errorf("ReadFile(/main.c) = %q, %v", data, err)
+	}
+
+	// 删除符号链接本身只删除链接，不影响链接目标
+	if err := f.workspaces.DeleteFile(ctx, f.alice, f.workspace.ID, "escape"); err != nil {
+		t.Fatalf("DeleteFile(escape) error = %v", err)
+	}
+	if _, err := os.Stat(secret); err != nil {
+		t.Errorf("symlink target removed: %v", err)
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/gofiber/fiber/v2/log"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/property"
+	"sync"
+	"time"
+)
+
+// idleCheckInterval 检查容器空闲状态的间隔
+const idleCheckInterval = 10 * time.Second
+
+// IdleWarning 容器即将因空闲被删除的预警
+type IdleWarning struct {
+	Remaining time.Duration // 距离删除的剩余时间
+}
+
+// activityTracker 记录每个容器最近一次活动时间（内存中，重启后重新计时）
+type activityTracker struct {
+	mu         sync.Mutex
+	startedAt  time.Time                             // 跟踪开始时间，未记录活动的容器以此为准
+	lastActive map[int]time.Time                     // 容器 ID -> 最近活动时间
+	warned     map[int]bool                          // 容器 ID -> 本轮空闲是否已预警
+	listeners  map[int]map[chan IdleWarning]struct{} // 容器 ID -> 已附加终端的预警通道
+}
+
+// newActivityTracker 创建活动跟踪器，从当前时间开始计时
+func newActivityTracker() *activityTracker {
+	return &activityTracker{
+		startedAt:  time.Now(),
+		lastActive: make(map[int]time.Time),
+		warned:     make(map[int]bool),
+		listeners:  make(map[int]map[chan IdleWarning]struct{}),
+	}
+}
+
+// TouchContainer 记录容器的一次活动（终端输入输出或 API 访问）
+// - `containerId`：容器 ID
+func (s *ContainerService) TouchContainer(containerId int) {
+	s.activity.mu.Lock()
+	defer s.activity.mu.Unlock()
+	s.activity.lastActive[containerId] = time.Now()
+	delete(s.activity.warned, containerId)
+}
+
+// SubscribeIdleWarning 订阅容器的空闲预警
+// - `containerId`：容器 ID
+// - 返回预警通道和取消订阅函数
+func (s *ContainerService) SubscribeIdleWarning(containerId int) (<-chan IdleWarning, func()) {
+	ch := make(chan IdleWarning, 1)
+
+	s.activity.mu.Lock()
+	if s.activity.listeners[containerId] == nil {
+		s.activity.listeners[containerId] = make(map[chan IdleWarning]struct{})
+	}
+	s.activity.listeners[containerId][ch] = struct{}{}
+	s.activity.mu.Unlock()
+
+	return ch, func() {
+		s.activity.mu.Lock()
+		defer s.activity.mu.Unlock()
+		delete(s.activity.listeners[containerId], ch)
+		if len(s.activity.listeners[containerId]) == 0 {
+			delete(s.activity.listeners, containerId)
+		}
+	}
+}
+
+// forgetActivity 停止跟踪已删除的容器
+func (s *ContainerService) forgetActivity(containerId int) {
+	s.activity.mu.Lock()
+	defer s.activity.mu.Unlock()
+	delete(s.activity.lastActive, containerId)
+	delete(s.activity.warned, containerId)
+}
+
+// idleSince 返回容器的最近活动时间
+// - `created`：容器记录的创建时间，未记录活动时使用
+func (t *activityTracker) idleSince(containerId int, created time.Time) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if last, ok := t.lastActive[containerId]; ok {
+		return last
+	}
+	// 重启前的活动已丢失，从跟踪开始时间重新计时
+	if created.Before(t.startedAt) {
+		return t.startedAt
+	}
+	return created
+}
+
+// warn 向已附加的终端发送一次空闲预警
+func (t *activityTracker) warn(containerId int, remaining time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.warned[containerId] {
+		return
+	}
+	t.warned[containerId] = true
+	for ch := range t.listeners[containerId] {
+		// 非阻塞发送，终端未及时读取时丢弃
+		select {
+		case ch <- IdleWarning{Remaining: remaining}:
+		default:
+		}
+	}
+}
+
+// StartIdleReaper 周期性地删除空闲超时的容器
+// - `ctx`：控制协程生命周期的上下文，取消后协程退出
+// - 配置的空闲超时为 0 时不启动
+func (s *ContainerService) StartIdleReaper(ctx context.Context) {
+	if s.conf.IdleTimeout <= 0 {
+		log.Info("idle container reaper disabled")
+		return
+	}
+
+	ticker := time.NewTicker(idleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.reapIdleContainers(ctx); err != nil {
+			log.Errorf("failed to reap idle containers: %v", err)
+		}
+	}
+}
+
+// reapIdleContainers 执行一次空闲检查：临近超时发送预警，超时则删除容器
+func (s *ContainerService) reapIdleContainers(ctx context.Context) error {
+	timeout := s.conf.IdleTimeout
+	warnBefore := s.conf.IdleWarning
+
+	records, err := s.database.Container.Query().
+		Where(container.ContainerStatusEQ(property.ContainerStatusUp)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, record := range records {
+		idle := now.Sub(s.activity.idleSince(record.ID, record.CreateTime))
+
+		switch {
+		case idle >= timeout:
+			// 空闲超时，删除容器并记录删除时间
+			err := s.removeContainer(ctx, record.ID, "idle timeout")
+			if err != nil && !errors.Is(err, ErrContainerNotRunning) {
+				log.Errorf("failed to remove idle container %d: %v", record.ID, err)
+				continue
+			}
+			log.Infof("removed container %d after %v idle", record.ID, idle.Round(time.Second))
+		case idle >= timeout-warnBefore:
+			s.activity.warn(record.ID, timeout-idle)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"liteide-backend/ent/property"
+	"testing"
+	"time"
+)
+
+func TestReapIdleContainers(t *testing.T) {
+	f := setupService(t)
+	f.containers.conf.IdleTimeout = 400 * time.Millisecond
+	f.containers.conf.IdleWarning = 300 * time.Millisecond // 空闲 100ms 后预警
+	ctx := context.Background()
+
+	record := createUpContainer(t, f)
+	warnings, unsubscribe := f.containers.SubscribeIdleWarning(record.ID)
+	defer unsubscribe()
+
+	reap := func() {
+		t.Helper()
+		if err := f.containers.reapIdleContainers(ctx); err != nil {
+			t.Fatalf("reapIdleContainers() error = %v", err)
+		}
+	}
+	expectWarning := func(want bool) {
+		t.Helper()
+		select {
+		case warning := <-warnings:
+			if !want {
+				t.Errorf("unexpected idle warning %+v", warning)
+			} else if warning.Remaining <= 0 || warning.Remaining > 300*time.Millisecond {
+				t.Errorf("warning remaining = %v, want within (0, 300ms]", warning.Remaining)
+			}
+		default:
+			if want {
+				t.Error("expected an idle warning")
+			}
+		}
+	}
+	status := func() property.ContainerStatus {
+		return f.containers.database.Container.GetX(ctx, record.ID).ContainerStatus
+	}
+
+	// 刚创建的容器不预警
+	reap()
+	expectWarning(false)
+
+	// 进入预警窗口后只预警一次
+	time.Sleep(150 * time.Millisecond)
+	reap()
+	expectWarning(true)
+	reap()
+	expectWarning(false)
+
+	// 查询容器详情视为活动，重新计时并允许再次预警
+	if _, err := f.containers.GetContainer(ctx, f.alice, record.ID); err != nil {
+		t.Fatalf("GetContainer() error = %v", err)
+	}
+	reap()
+	expectWarning(false)
+	time.Sleep(150 * time.Millisecond)
+	reap()
+	expectWarning(true)
+	if got := status(); got != property.ContainerStatusUp {
+		t.Fatalf("status before timeout = %s, want %s", got, property.ContainerStatusUp)
+	}
+
+	// 超时后删除容器并记录原因
+	time.Sleep(300 * time.Millisecond)
+	reap()
+	removed := f.containers.database.Container.GetX(ctx, record.ID)
+	if removed.ContainerStatus != property.ContainerStatusRemoved || removed.StatusMessage == nil || *removed.StatusMessage != "idle timeout" {
+		t.Errorf("container after timeout = %s %v, want %s with idle timeout", removed.ContainerStatus, removed.StatusMessage, property.ContainerStatusRemoved)
+	}
+	if instances, _ := f.runtime.List(ctx, ""); len(instances) != 0 {
+		t.Errorf("runtime has %d instances, want 0", len(instances))
+	}
+
+	// 非 Up 状态的容器不受影响
+	if got := f.containers.database.Container.GetX(ctx, f.container.ID); got.ContainerStatus != property.ContainerStatusRemoved || got.StatusMessage != nil {
+		t.Errorf("removed container was modified: %+v", got)
+	}
+}
+
+func TestStartIdleReaperDisabled(t *testing.T) {
+	f := setupService(t)
+	f.containers.conf.IdleTimeout = 0
+
+	// 空闲超时为 0 时立即返回，不会阻塞到上下文取消
+	done := make(chan struct{})
+	go func() {
+		f.containers.StartIdleReaper(context.Background())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("StartIdleReaper() did not return with idle timeout disabled")
+	}
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"liteide-backend/config"
+	"liteide-backend/ent"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/docker"
+	"liteide-backend/repository/model"
+	"liteide-backend/svc"
+)
+
+// ImageService 镜像目录、镜像预拉取与语言工具链
+type ImageService struct {
+	database     *ent.Client             // 数据库客户端
+	runtime      docker.ContainerRuntime // 容器运行时，用于拉取镜像
+	conf         config.AppConfig        // 应用配置
+	languages    *model.Registry         // 语言工具链注册表
+	pullRequests chan int                // 等待预拉取的镜像 ID 队列
+}
+
+// NewImageService 使用服务上下文创建镜像服务
+func NewImageService(sc *svc.ServiceContext) *ImageService {
+	return &ImageService{
+		database:     sc.Database,
+		runtime:      sc.Runtime,
+		conf:         sc.AppConfig,
+		languages:    sc.Languages,
+		pullRequests: make(chan int, 64),
+	}
+}
+
+// ImageInput 创建或更新镜像时的字段
+// - 更新时为 nil 的字段保持不变
+// - 更新时 Digest 为空字符串、资源字段为 0、NetworkMode 为空字符串表示清除该字段（恢复默认值）
+type ImageInput struct {
+	Language    *property.Language    // 编程语言
+	ImageName   *string               // Docker 镜像名称，例如 gcc:13
+	Digest      *string               // 镜像摘要，例如 sha256:…
+	NanoCPUs    *int64                // CPU 上限（单位：1e-9 核）
+	MemoryBytes *int64                // 内存上限（字节）
+	PidsLimit   *int64                // 进程数上限
+	NetworkMode *property.NetworkMode // 网络隔离策略
+}
+
+// imageReference 返回创建容器实例时使用的镜像引用
+// - 设置了摘要时返回 name@digest，确保所有节点运行同一版本的镜像
+func imageReference(imageInstance *ent.Image) string {
+	if imageInstance.Digest != nil {
+		return imageInstance.ImageName + "@" + *imageInstance.Digest
+	}
+	return imageInstance.ImageName
+}
+
+// ListImages 分页查询镜像目录
+// - `ctx`：请求的上下文
+// - `offset`、`limit`：分页参数
+// - 返回当前页的镜像及镜像总数
+func (s *ImageService) ListImages(ctx context.Context, offset int, limit int) ([]*ent.Image, int, error) {
+	query := s.database.Image.Query()
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	images, err := query.
+		Order(ent.Asc(image.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return images, total, nil
+}
+
+// CreateImage 创建镜像记录，并将其加入预拉取队列
+// - `ctx`：请求的上下文
+// - `input`：镜像字段，Language 与 ImageName 必填
+func (s *ImageService) CreateImage(ctx context.Context, input ImageInput) (*ent.Image, error) {
+	if input.Language == nil || input.ImageName == nil {
+		return nil, fmt.Errorf("%w: language and image_name are required", ErrInvalidImage)
+	}
+	if _, ok := s.languages.Lookup(*input.Language); !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLanguage, *input.Language)
+	}
+
+	create := s.database.Image.Create().
+		SetLanguage(*input.Language).
+		SetImageName(*input.ImageName)
+	if input.Digest != nil && *input.Digest != "" {
+		create.SetDigest(*input.Digest)
+	}
+	if input.NanoCPUs != nil && *input.NanoCPUs != 0 {
+		create.SetNanoCpus(*input.NanoCPUs)
+	}
+	if input.MemoryBytes != nil && *input.MemoryBytes != 0 {
+		create.SetMemoryBytes(*input.MemoryBytes)
+	}
+	if input.PidsLimit != nil && *input.PidsLimit != 0 {
+		create.SetPidsLimit(*input.PidsLimit)
+	}
+	if input.NetworkMode != nil && *input.NetworkMode != "" {
+		create.SetNetworkMode(*input.NetworkMode)
+	}
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.requestImagePull(created.ID)
+	return created, nil
+}
+
+// UpdateImage 更新镜像记录
+// - `ctx`：请求的上下文
+// - `imageId`：镜像 ID
+// - `input`：需要更新的字段
+// - 镜像名称或摘要变更时重置预拉取状态并重新拉取
+func (s *ImageService) UpdateImage(ctx context.Context, imageId int, input ImageInput) (*ent.Image, error) {
+	if input.Language != nil {
+		if _, ok := s.languages.Lookup(*input.Language); !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownLanguage, *input.Language)
+		}
+	}
+
+	current, err := s.database.Image.Get(ctx, imageId)
+	if err != nil {
+		return nil, err
+	}
+
+	update := current.Update()
+	if input.Language != nil {
+		update.SetLanguage(*input.Language)
+	}
+	if input.ImageName != nil {
+		update.SetImageName(*input.ImageName)
+	}
+	if input.Digest != nil {
+		if *input.Digest == "" {
+			update.ClearDigest()
+		} else {
+			update.SetDigest(*input.Digest)
+		}
+	}
+	if input.NanoCPUs != nil {
+		if *input.NanoCPUs == 0 {
+			update.ClearNanoCpus()
+		} else {
+			update.SetNanoCpus(*input.NanoCPUs)
+		}
+	}
+	if input.MemoryBytes != nil {
+		if *input.MemoryBytes == 0 {
+			update.ClearMemoryBytes()
+		} else {
+			update.SetMemoryBytes(*input.MemoryBytes)
+		}
+	}
+	if input.PidsLimit != nil {
+		if *input.PidsLimit == 0 {
+			update.ClearPidsLimit()
+		} else {
+			update.SetPidsLimit(*input.PidsLimit)
+		}
+	}
+	if input.NetworkMode != nil {
+		if *input.NetworkMode == "" {
+			update.ClearNetworkMode()
+		} else {
+			update.SetNetworkMode(*input.NetworkMode)
+		}
+	}
+
+	// 镜像引用变更后，节点上已拉取的镜像不再有效
+	referenceChanged := (input.ImageName != nil && *input.ImageName != current.ImageName) ||
+		(input.Digest != nil && *input.Digest != valueOr(current.Digest, ""))
+	if referenceChanged {
+		update.SetPullStatus(property.ImagePullStatusPending).
+			ClearPullMessage().
+			ClearPullTime()
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if referenceChanged {
+		s.requestImagePull(updated.ID)
+	}
+	return updated, nil
+}
+
+// DeleteImage 删除镜像记录
+// - `ctx`：请求的上下文
+// - `imageId`：镜像 ID
+// - 仍有容器记录引用该镜像时返回 ErrImageInUse
+func (s *ImageService) DeleteImage(ctx context.Context, imageId int) error {
+	inUse, err := s.database.Container.Query().
+		Where(container.HasImageWith(image.ID(imageId))).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return ErrImageInUse
+	}
+	return s.database.Image.DeleteOneID(imageId).Exec(ctx)
+}
+
+// PullImage 将镜像加入预拉取队列，由后台预拉取协程执行
+// - `ctx`：请求的上下文
+// - `imageId`：镜像 ID
+func (s *ImageService) PullImage(ctx context.Context, imageId int) error {
+	if err := s.database.Image.UpdateOneID(imageId).
+		SetPullStatus(property.ImagePullStatusPending).
+		Exec(ctx); err != nil {
+		return err
+	}
+	s.requestImagePull(imageId)
+	return nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2/log"
+	"liteide-backend/ent/image"
+	"liteide-backend/repository/model"
+)
+
+// ListLanguages 返回所有可用的语言工具链
+func (s *ImageService) ListLanguages() []model.Toolchain {
+	return s.languages.List()
+}
+
+// SyncLanguageImages 为尚未配置镜像的语言创建默认镜像记录
+// - 已存在的镜像记录不会被覆盖，以便通过数据库调整镜像
+func (s *ImageService) SyncLanguageImages(ctx context.Context) error {
+	for _, toolchain := range s.languages.List() {
+		exists, err := s.database.Image.Query().
+			Where(image.Language(toolchain.Name)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		if err := s.database.Image.Create().
+			SetLanguage(toolchain.Name).
+			SetImageName(toolchain.Image).
+			Exec(ctx); err != nil {
+			return err
+		}
+		log.Infof("image created for language %s: %s", toolchain.Name, toolchain.Image)
+	}
+	return nil
+}
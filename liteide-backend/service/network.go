@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"liteide-backend/ent"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/docker"
+)
+
+// containerNetwork 计算容器需要接入的网络
+// - `workspaceInstance`：容器挂载的工作区，其网络策略优先
+// - `imageInstance`：容器使用的镜像，其次使用镜像的网络策略
+// - 返回网络名称，以及该网络是否禁止出站访问；网络由 ensureNetwork 创建
+func (s *ContainerService) containerNetwork(workspaceInstance *ent.Workspace, imageInstance *ent.Image) (string, bool, error) {
+	conf := s.conf.NetworkConfig
+
+	mode := conf.DefaultMode
+	if imageInstance.NetworkMode != nil {
+		mode = *imageInstance.NetworkMode
+	}
+	if workspaceInstance.NetworkMode != nil {
+		mode = *workspaceInstance.NetworkMode
+	}
+
+	switch mode {
+	case property.NetworkModeNone:
+		return docker.NetworkNone, true, nil
+	case property.NetworkModeInternal:
+		return conf.InternalNetwork, true, nil
+	case property.NetworkModeEgress:
+		return conf.EgressNetwork, false, nil
+	default:
+		return "", false, fmt.Errorf("unknown network mode: %s", mode)
+	}
+}
+
+// ensureNetwork 确保容器需要接入的网络存在，不存在时由容器运行时创建
+// - `network`：网络名称，NetworkNone 无需创建
+// - `internal`：网络是否禁止出站访问
+func (s *ContainerService) ensureNetwork(ctx context.Context, network string, internal bool) error {
+	if network == docker.NetworkNone {
+		return nil
+	}
+	return s.runtime.EnsureNetwork(ctx, network, internal)
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"liteide-backend/config"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/docker"
+	"testing"
+)
+
+func TestContainerNetwork(t *testing.T) {
+	none, internal, egress := property.NetworkModeNone, property.NetworkModeInternal, property.NetworkModeEgress
+	tests := []struct {
+		name      string
+		fallback  property.NetworkMode  // 配置的默认策略
+		image     *property.NetworkMode // 镜像的策略
+		workspace *property.NetworkMode // 工作区的策略
+		want      string
+		internal  bool
+	}{
+		{name: "config default", fallback: egress, want: "liteide-egress"},
+		{name: "config none", fallback: none, want: docker.NetworkNone, internal: true},
+		{name: "image over config", fallback: egress, image: &internal, want: "liteide-internal", internal: true},
+		{name: "workspace over image", fallback: none, image: &internal, workspace: &egress, want: "liteide-egress"},
+		{name: "workspace none over image", fallback: egress, image: &egress, workspace: &none, want: docker.NetworkNone, internal: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			ctx := context.Background()
+			f.containers.conf.NetworkConfig = config.NetworkConfig{
+				DefaultMode:     tt.fallback,
+				InternalNetwork: "liteide-internal",
+				EgressNetwork:   "liteide-egress",
+			}
+			f.container.QueryImage().OnlyX(ctx).Update().SetNillableNetworkMode(tt.image).ExecX(ctx)
+			f.workspace = f.workspaces.database.Workspace.UpdateOneID(f.workspace.ID).SetNillableNetworkMode(tt.workspace).SaveX(ctx)
+
+			record := createUpContainer(t, f)
+			spec, _ := f.runtime.Spec(*record.ContainerID)
+			if spec.Network != tt.want {
+				t.Errorf("network = %q, want %q", spec.Network, tt.want)
+			}
+
+			// 只有实际接入的网络才会被创建
+			for _, name := range []string{"liteide-internal", "liteide-egress"} {
+				isInternal, created := f.runtime.Network(name)
+				if created != (name == tt.want) {
+					t.Errorf("network %s created = %v, want %v", name, created, name == tt.want)
+				}
+				if created && isInternal != tt.internal {
+					t.Errorf("network %s internal = %v, want %v", name, isInternal, tt.internal)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateContainerNetworkAfterQuota(t *testing.T) {
+	f := setupService(t)
+	ctx := context.Background()
+	f.containers.conf.NetworkConfig = config.NetworkConfig{
+		DefaultMode:     property.NetworkModeEgress,
+		InternalNetwork: "liteide-internal",
+		EgressNetwork:   "liteide-egress",
+	}
+	f.workspaces.conf.QuotaConfig = config.QuotaConfig{MaxContainers: 1}
+	createUpContainer(t, f)
+	f.runtime = docker.NewFakeRuntime()
+	f.containers.runtime = f.runtime
+
+	// 被配额拒绝的请求不创建网络
+	if _, err := f.containers.CreateContainer(ctx, f.alice, f.workspace.ID); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("CreateContainer() error = %v, want %v", err, ErrQuotaExceeded)
+	}
+	if _, created := f.runtime.Network("liteide-egress"); created {
+		t.Error("network created for a request rejected by quota")
+	}
+
+	// 网络创建失败时不创建实例，记录标记为 Removed
+	f.workspaces.conf.QuotaConfig = config.QuotaConfig{}
+	f.runtime.FailOn(docker.FakeOpNetwork, errBoom)
+	if _, err := f.containers.CreateContainer(ctx, f.alice, f.workspace.ID); !errors.Is(err, ErrDocker) {
+		t.Fatalf("CreateContainer() error = %v, want %v", err, ErrDocker)
+	}
+	if record := latestContainer(t, f); record.ContainerStatus != property.ContainerStatusRemoved {
+		t.Errorf("status = %s, want %s", record.ContainerStatus, property.ContainerStatusRemoved)
+	}
+	if instances, _ := f.runtime.List(ctx, ""); len(instances) != 0 {
+		t.Errorf("runtime has %d instances, want 0", len(instances))
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2/log"
+	"liteide-backend/ent"
+	"liteide-backend/ent/image"
+	"liteide-backend/ent/predicate"
+	"liteide-backend/ent/property"
+	"strconv"
+	"time"
+)
+
+// requestImagePull 将镜像加入预拉取队列
+// - 队列已满时丢弃请求，镜像会在下一次周期性预拉取时处理
+func (s *ImageService) requestImagePull(imageId int) {
+	select {
+	case s.pullRequests <- imageId:
+	default:
+		log.Warnf("image pull queue is full, image %d will be pulled later", imageId)
+	}
+}
+
+// StartImagePuller 预拉取镜像目录中的镜像（Swarm 下拉取到所有节点）
+// - `ctx`：控制预拉取协程生命周期的上下文，取消后协程退出
+// - 启动时拉取全部镜像，此后按 ImagePullInterval 周期性拉取，并处理镜像变更触发的拉取请求
+func (s *ImageService) StartImagePuller(ctx context.Context) {
+	var tick <-chan time.Time
+	if interval := s.conf.ImagePullInterval; interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	s.pullAllImages(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+			s.pullAllImages(ctx)
+		case imageId := <-s.pullRequests:
+			imageInstance, err := s.database.Image.Get(ctx, imageId)
+			if err != nil {
+				log.Errorf("failed to load image %d for pulling: %v", imageId, err)
+				continue
+			}
+			s.pullImage(ctx, imageInstance)
+		}
+	}
+}
+
+// pullAllImages 依次预拉取镜像目录中的所有镜像
+func (s *ImageService) pullAllImages(ctx context.Context) {
+	images, err := s.database.Image.Query().All(ctx)
+	if err != nil {
+		log.Errorf("failed to list images for pulling: %v", err)
+		return
+	}
+	for _, imageInstance := range images {
+		if ctx.Err() != nil {
+			return
+		}
+		s.pullImage(ctx, imageInstance)
+	}
+}
+
+// pullImage 拉取镜像，并记录拉取结果
+// - `imageInstance`：拉取开始时读取的镜像记录
+// - 拉取期间镜像引用被修改时不写回结果，修改后的引用由 UpdateImage 触发的拉取请求处理
+func (s *ImageService) pullImage(ctx context.Context, imageInstance *ent.Image) {
+	reference := imageReference(imageInstance)
+	log.Infof("pulling image %d: %s", imageInstance.ID, reference)
+
+	affected, err := s.database.Image.Update().
+		Where(sameImageReference(imageInstance)).
+		SetPullStatus(property.ImagePullStatusPulling).
+		Save(ctx)
+	if err != nil {
+		log.Errorf("failed to update pull status of image %d: %v", imageInstance.ID, err)
+		return
+	}
+	if affected == 0 {
+		log.Infof("image %d was changed or deleted, skip pulling %s", imageInstance.ID, reference)
+		return
+	}
+
+	err = s.runPullJob(ctx, imageInstance.ID, reference)
+
+	// 记录拉取结果（协程退出时上下文已取消，仍需写回状态）
+	update := s.database.Image.Update().
+		Where(sameImageReference(imageInstance)).
+		SetPullTime(time.Now())
+	if err != nil {
+		log.Errorf("failed to pull image %d: %v", imageInstance.ID, err)
+		update.SetPullStatus(property.ImagePullStatusFailed).SetPullMessage(err.Error())
+	} else {
+		log.Infof("image %d pulled: %s", imageInstance.ID, reference)
+		update.SetPullStatus(property.ImagePullStatusPulled).ClearPullMessage()
+	}
+	affected, err = update.Save(context.WithoutCancel(ctx))
+	if err != nil {
+		log.Errorf("failed to update pull status of image %d: %v", imageInstance.ID, err)
+	} else if affected == 0 {
+		log.Infof("image %d was changed during pulling, discard result of %s", imageInstance.ID, reference)
+	}
+}
+
+// sameImageReference 匹配镜像引用与 `imageInstance` 相同的镜像记录
+func sameImageReference(imageInstance *ent.Image) predicate.Image {
+	digest := image.DigestIsNil()
+	if imageInstance.Digest != nil {
+		digest = image.Digest(*imageInstance.Digest)
+	}
+	return image.And(image.ID(imageInstance.ID), image.ImageName(imageInstance.ImageName), digest)
+}
+
+// runPullJob 通过容器运行时拉取镜像
+// - `ctx`：上下文
+// - `imageId`：镜像 ID，用于拉取任务命名
+// - `reference`：镜像引用
+func (s *ImageService) runPullJob(ctx context.Context, imageId int, reference string) error {
+	name := s.conf.ImagePullServicePrefix + strconv.Itoa(imageId)
+	if err := s.runtime.PullImage(ctx, name, reference, s.conf.ImagePullTimeout); err != nil {
+		return runtimeError(err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/docker"
+	"strings"
+	"testing"
+)
+
+func TestPullImage(t *testing.T) {
+	newName := "gcc:14"
+	tests := []struct {
+		name    string
+		fail    error                   // 注入到拉取操作的错误
+		change  func(f fixture, id int) // 拉取开始前修改镜像记录，模拟管理员在拉取期间的修改
+		status  property.ImagePullStatus
+		message string // 拉取失败原因应包含的内容，为空时不应有失败原因
+		pulled  bool   // 是否记录拉取时间
+	}{
+		{name: "pulled", status: property.ImagePullStatusPulled, pulled: true},
+		{name: "pull fails", fail: errBoom, status: property.ImagePullStatusFailed, message: "boom", pulled: true},
+		{
+			name: "reference changed",
+			change: func(f fixture, id int) {
+				if _, err := f.images.UpdateImage(context.Background(), id, ImageInput{ImageName: &newName}); err != nil {
+					t.Fatalf("UpdateImage() error = %v", err)
+				}
+			},
+			status: property.ImagePullStatusPending,
+		},
+		{
+			name: "resources changed",
+			change: func(f fixture, id int) {
+				pids := int64(32)
+				if _, err := f.images.UpdateImage(context.Background(), id, ImageInput{PidsLimit: &pids}); err != nil {
+					t.Fatalf("UpdateImage() error = %v", err)
+				}
+			},
+			status: property.ImagePullStatusPulled,
+			pulled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			ctx := context.Background()
+			if tt.fail != nil {
+				f.runtime.FailOn(docker.FakeOpPull, tt.fail)
+			}
+
+			snapshot := f.container.QueryImage().OnlyX(ctx)
+			if tt.change != nil {
+				tt.change(f, snapshot.ID)
+			}
+			f.images.pullImage(ctx, snapshot)
+
+			got := f.images.database.Image.GetX(ctx, snapshot.ID)
+			if got.PullStatus != tt.status {
+				t.Errorf("pull status = %s, want %s", got.PullStatus, tt.status)
+			}
+			if tt.message == "" && got.PullMessage != nil {
+				t.Errorf("pull message = %q, want none", *got.PullMessage)
+			}
+			if tt.message != "" && (got.PullMessage == nil || !strings.Contains(*got.PullMessage, tt.message)) {
+				t.Errorf("pull message = %v, want containing %q", got.PullMessage, tt.message)
+			}
+			if (got.PullTime != nil) != tt.pulled {
+				t.Errorf("pull time = %v, want recorded = %v", got.PullTime, tt.pulled)
+			}
+		})
+	}
+}
+
+func TestPullAllImages(t *testing.T) {
+	f := setupService(t)
+	ctx := context.Background()
+	f.images.database.Image.Create().SetLanguage("PYTHON").SetImageName("python:3.12").SaveX(ctx)
+	f.runtime.FailOn(docker.FakeOpPull, errBoom)
+
+	f.images.pullAllImages(ctx)
+	images := f.images.database.Image.Query().AllX(ctx)
+	for _, imageInstance := range images {
+		if imageInstance.PullStatus != property.ImagePullStatusFailed {
+			t.Errorf("image %s pull status = %s, want %s", imageInstance.ImageName, imageInstance.PullStatus, property.ImagePullStatusFailed)
+		}
+	}
+
+	// 故障恢复后再次拉取成功并清除失败原因
+	f.runtime.FailOn(docker.FakeOpPull, nil)
+	f.images.pullAllImages(ctx)
+	for _, imageInstance := range f.images.database.Image.Query().AllX(ctx) {
+		if imageInstance.PullStatus != property.ImagePullStatusPulled || imageInstance.PullMessage != nil {
+			t.Errorf("image %s = %s %v, want %s without message", imageInstance.ImageName, imageInstance.PullStatus, imageInstance.PullMessage, property.ImagePullStatusPulled)
+		}
+	}
+	if len(images) != 2 {
+		t.Errorf("pulled %d images, want 2", len(images))
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"liteide-backend/ent"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/workspace"
+	"path/filepath"
+)
+
+// Usage 用户当前的资源占用
+type Usage struct {
+	Containers int   // 处于 Pending 或 Up 状态的容器数
+	Workspaces int   // 工作区数
+	DiskBytes  int64 // 所有工作区目录占用的磁盘空间（字节）
+}
+
+// GetUsage 统计用户当前的资源占用
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+func (s *WorkspaceService) GetUsage(ctx context.Context, user *ent.User) (*Usage, error) {
+	containers, err := s.countActiveContainers(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	workspaces, err := s.database.Workspace.Query().
+		Where(workspace.UserID(user.ID)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diskBytes, err := s.diskUsage(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &Usage{Containers: containers, Workspaces: workspaces, DiskBytes: diskBytes}, nil
+}
+
+// withQuotaLock 在配额锁内执行 `fn`
+func (s *WorkspaceService) withQuotaLock(fn func() error) error {
+	s.quotaMu.Lock()
+	defer s.quotaMu.Unlock()
+	return fn()
+}
+
+// countActiveContainers 统计用户处于 Pending 或 Up 状态的容器数
+func (s *WorkspaceService) countActiveContainers(ctx context.Context, userId int) (int, error) {
+	return s.database.Container.Query().
+		Where(
+			container.UserID(userId),
+			container.ContainerStatusIn(property.ContainerStatusPending, property.ContainerStatusUp),
+		).
+		Count(ctx)
+}
+
+// checkContainerQuota 检查用户能否再创建一个容器
+func (s *WorkspaceService) checkContainerQuota(ctx context.Context, userId int) error {
+	limit := s.conf.QuotaConfig.MaxContainers
+	if limit <= 0 {
+		return nil
+	}
+	count, err := s.countActiveContainers(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return fmt.Errorf("%w: at most %d running containers", ErrQuotaExceeded, limit)
+	}
+	return nil
+}
+
+// checkWorkspaceQuota 检查用户能否再创建一个工作区
+func (s *WorkspaceService) checkWorkspaceQuota(ctx context.Context, userId int) error {
+	limit := s.conf.QuotaConfig.MaxWorkspaces
+	if limit <= 0 {
+		return nil
+	}
+	count, err := s.database.Workspace.Query().
+		Where(workspace.UserID(userId)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return fmt.Errorf("%w: at most %d workspaces", ErrQuotaExceeded, limit)
+	}
+	return nil
+}
+
+// checkDiskQuota 检查用户的磁盘占用增加 `delta` 字节后是否超出配额
+// - 配额按工作区所有者计算
+// - 容器内进程可以直接写入挂载的工作区目录，此处只能约束通过文件 API 的写入
+func (s *WorkspaceService) checkDiskQuota(ctx context.Context, userId int, delta int64) error {
+	limit := s.conf.QuotaConfig.MaxDiskBytes
+	if limit <= 0 {
+		return nil
+	}
+	used, err := s.diskUsage(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if used+delta > limit {
+		return fmt.Errorf("%w: disk usage would be %d of %d bytes", ErrQuotaExceeded, used+delta, limit)
+	}
+	return nil
+}
+
+// diskUsage 统计用户所有工作区目录占用的磁盘空间
+func (s *WorkspaceService) diskUsage(ctx context.Context, userId int) (int64, error) {
+	workspaces, err := s.database.Workspace.Query().
+		Where(workspace.UserID(userId)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, workspaceInstance := range workspaces {
+		size, err := directorySize(s.WorkspaceDirectory(workspaceInstance))
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	return total, nil
+}
+
+// directorySize 统计目录内普通文件的总大小，目录不存在时返回 0
+func directorySize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// 遍历过程中被删除的文件不计入
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
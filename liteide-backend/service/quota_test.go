@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"liteide-backend/config"
+	"liteide-backend/ent/property"
+	"testing"
+)
+
+func TestContainerQuota(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int
+		status property.ContainerStatus // 已有容器记录的状态
+		want   error
+	}{
+		{name: "running container counts", limit: 1, status: property.ContainerStatusUp, want: ErrQuotaExceeded},
+		{name: "pending container counts", limit: 1, status: property.ContainerStatusPending, want: ErrQuotaExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			f.workspaces.conf.QuotaConfig = config.QuotaConfig{MaxContainers: tt.limit}
+			f.containers.conf.NetworkConfig.DefaultMode = property.NetworkModeNone
+			f.containers.conf.ResourceConfig = config.ResourceConfig{NanoCPUs: 1e9, MemoryBytes: 1 << 30, PidsLimit: 64}
+			f.container.Update().SetContainerStatus(tt.status).ExecX(context.Background())
+
+			if _, err := f.containers.CreateContainer(context.Background(), f.alice, f.workspace.ID); !errors.Is(err, tt.want) {
+				t.Errorf("CreateContainer() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkspaceQuota(t *testing.T) {
+	f := setupService(t)
+	f.workspaces.conf.QuotaConfig = config.QuotaConfig{MaxWorkspaces: 1}
+
+	tests := []struct {
+		name string
+		user string
+		want error
+	}{
+		{name: "owner at limit", user: "alice", want: ErrQuotaExceeded},
+		{name: "other user", user: "bob", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := f.alice
+			if tt.user == "bob" {
+				user = f.bob
+			}
+			if _, err := f.workspaces.CreateWorkspace(context.Background(), user, "another", "C", nil); !errors.Is(err, tt.want) {
+				t.Errorf("CreateWorkspace() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiskQuota(t *testing.T) {
+	f := setupService(t)
+	f.workspaces.conf.QuotaConfig = config.QuotaConfig{MaxDiskBytes: 16}
+	ctx := context.Background()
+
+	// 按顺序执行，每一步都基于上一步的磁盘占用
+	steps := []struct {
+		name string
+		do   func() error
+		want error
+	}{
+		{name: "write within quota", do: func() error {
+			return f.workspaces.WriteFile(ctx, f.alice, f.workspace.ID, "a.txt", make([]byte, 10))
+		}},
+		{name: "overwrite counts delta", do: func() error {
+			return f.workspaces.WriteFile(ctx, f.alice, f.workspace.ID, "a.txt", make([]byte, 16))
+		}},
+		{name: "create empty file at limit", do: func() error {
+			return f.workspaces.CreateFile(ctx, f.alice, f.workspace.ID, "b.txt", false)
+		}},
+		{name: "write beyond quota", do: func() error {
+			return f.workspaces.WriteFile(ctx, f.alice, f.workspace.ID, "b.txt", []byte("x"))
+		}, want: ErrQuotaExceeded},
+		{name: "admin write counts against owner", do: func() error {
+			return f.workspaces.WriteFile(ctx, f.admin, f.workspace.ID, "c.txt", []byte("x"))
+		}, want: ErrQuotaExceeded},
+	}
+	for _, step := range steps {
+		if err := step.do(); !errors.Is(err, step.want) {
+			t.Fatalf("%s: error = %v, want %v", step.name, err, step.want)
+		}
+	}
+
+	usage, err := f.workspaces.GetUsage(ctx, f.alice)
+	if err != nil {
+		t.Fatalf("GetUsage() error = %v", err)
+	}
+	if usage.DiskBytes != 16 || usage.Workspaces != 1 || usage.Containers != 0 {
+		t.Errorf("GetUsage() = %+v, want 16 bytes, 1 workspace, 0 containers", *usage)
+	}
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"github.com/gofiber/fiber/v2/log"
+	"liteide-backend/ent"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/docker"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StartReconciler 周期性地对账数据库中的容器记录与容器运行时中的实例
+// - `ctx`：控制对账协程生命周期的上下文，取消后协程退出
+func (s *ContainerService) StartReconciler(ctx context.Context) {
+	ticker := time.NewTicker(s.conf.ReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Reconcile(ctx); err != nil {
+			log.Errorf("failed to reconcile containers: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile 执行一次对账
+// - Up 记录对应的实例已不存在：标记为 Removed
+// - Up 记录对应的实例已崩溃或退出：删除实例并标记为 Error
+// - Pending 记录超过启动超时仍未完成：实例运行中则标记为 Up，否则删除实例并标记为 Error
+// - 带有容器实例前缀、但没有对应 Pending/Up 记录的实例：视为孤儿实例并删除
+func (s *ContainerService) Reconcile(ctx context.Context) error {
+	prefix := s.conf.ContainerServicePrefix
+
+	// 列出所有带有容器实例前缀的运行时实例
+	instances, err := s.runtime.List(ctx, prefix)
+	if err != nil {
+		return runtimeError(err)
+	}
+
+	// 按容器记录 ID 索引实例
+	instancesByRecord := make(map[int]docker.Instance, len(instances))
+	for _, instance := range instances {
+		id, err := strconv.Atoi(strings.TrimPrefix(instance.Name, prefix))
+		if err != nil {
+			continue // 不是由本后端创建的实例
+		}
+		instancesByRecord[id] = instance
+	}
+
+	// 查询所有处于 Pending 或 Up 状态的容器记录
+	records, err := s.database.Container.Query().
+		Where(container.ContainerStatusIn(property.ContainerStatusPending, property.ContainerStatusUp)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Pending 超过该时间的记录不再可能由创建请求完成
+	staleBefore := time.Now().Add(-2 * s.conf.ContainerStartTimeout)
+
+	for _, record := range records {
+		instance, exists := instancesByRecord[record.ID]
+		delete(instancesByRecord, record.ID) // 剩余的即为孤儿实例
+
+		switch record.ContainerStatus {
+		case property.ContainerStatusUp:
+			if !exists {
+				s.forgetActivity(record.ID)
+				s.reconcileStatus(ctx, record, property.ContainerStatusRemoved, "instance removed out-of-band")
+				continue
+			}
+			s.reconcileUp(ctx, record, instance)
+		case property.ContainerStatusPending:
+			if record.CreateTime.After(staleBefore) {
+				continue // 创建请求可能仍在等待实例启动
+			}
+			s.reconcilePending(ctx, record, instance, exists)
+		}
+	}
+
+	// 删除孤儿实例
+	for id, instance := range instancesByRecord {
+		if err := s.runtime.Remove(ctx, instance.ID); err != nil {
+			log.Errorf("reconcile: failed to remove orphan instance %s: %v", instance.Name, err)
+			continue
+		}
+		log.Infof("reconcile: removed orphan instance %s (container %d)", instance.Name, id)
+	}
+	return nil
+}
+
+// reconcileUp 检查 Up 记录对应的实例是否仍在运行
+// - 实例已崩溃或退出时删除实例，并将记录标记为 Error，原因取实例的状态说明
+// - 实例处于重启中等过渡状态时由运行时自行恢复，留待下一轮对账
+func (s *ContainerService) reconcileUp(ctx context.Context, record *ent.Container, instance docker.Instance) {
+	state, err := s.runtime.Inspect(ctx, instance.ID)
+	if err != nil {
+		log.Errorf("reconcile: failed to inspect instance %s: %v", instance.Name, err)
+		return
+	}
+	if state == nil || !state.Terminated() {
+		return
+	}
+
+	// 删除失败时实例在下一轮对账中作为孤儿实例删除
+	if err := s.runtime.Remove(ctx, instance.ID); err != nil {
+		log.Errorf("reconcile: failed to remove instance %s: %v", instance.Name, err)
+	}
+	reason := state.Message
+	if reason == "" {
+		reason = "instance " + state.State
+	}
+	s.forgetActivity(record.ID)
+	s.reconcileStatus(ctx, record, property.ContainerStatusError, reason)
+}
+
+// reconcilePending 修正创建过程中断（例如后端重启）遗留的 Pending 记录
+func (s *ContainerService) reconcilePending(ctx context.Context, record *ent.Container, instance docker.Instance, exists bool) {
+	if exists {
+		state, err := s.runtime.Inspect(ctx, instance.ID)
+		if err != nil {
+			log.Errorf("reconcile: failed to inspect instance %s: %v", instance.Name, err)
+			return
+		}
+		if state != nil && state.Running {
+			// 实例已启动，补全记录
+			err := s.database.Container.UpdateOne(record).
+				SetContainerStatus(property.ContainerStatusUp).
+				SetContainerID(instance.ID).
+				Exec(ctx)
+			if err != nil {
+				log.Errorf("reconcile: failed to update container %d: %v", record.ID, err)
+				return
+			}
+			log.Infof("reconcile: container %d %s -> %s", record.ID, record.ContainerStatus, property.ContainerStatusUp)
+			return
+		}
+
+		// 实例未能启动，删除实例
+		if err := s.runtime.Remove(ctx, instance.ID); err != nil {
+			log.Errorf("reconcile: failed to remove instance %s: %v", instance.Name, err)
+			return
+		}
+	}
+	s.reconcileStatus(ctx, record, property.ContainerStatusError, "container creation was interrupted")
+}
+
+// reconcileStatus 将容器记录修正为终止状态（Removed 或 Error），并记录原因
+func (s *ContainerService) reconcileStatus(ctx context.Context, record *ent.Container, status property.ContainerStatus, reason string) {
+	err := s.database.Container.UpdateOne(record).
+		SetContainerStatus(status).
+		SetStatusMessage(reason).
+		ClearContainerID().
+		SetExitTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		log.Errorf("reconcile: failed to update container %d: %v", record.ID, err)
+		return
+	}
+	log.Infof("reconcile: container %d %s -> %s: %s", record.ID, record.ContainerStatus, status, reason)
+}
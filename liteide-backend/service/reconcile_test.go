@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"liteide-backend/ent"
+	"liteide-backend/ent/property"
+	"liteide-backend/repository/docker"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// createPendingContainer 创建一条超过启动超时的 Pending 记录
+// - `withInstance`：是否在运行时中创建对应的实例
+func createPendingContainer(t *testing.T, f fixture, withInstance bool) *ent.Container {
+	t.Helper()
+	ctx := context.Background()
+	// create_time 不可更新，创建时直接设置为很久以前，模拟创建请求已中断
+	record := f.containers.database.Container.Create().
+		SetUser(f.alice).
+		SetWorkspace(f.workspace).
+		SetImage(f.container.QueryImage().OnlyX(ctx)).
+		SetContainerStatus(property.ContainerStatusPending).
+		SetCreateTime(time.Now().Add(-time.Minute)).
+		SaveX(ctx)
+
+	if withInstance {
+		if _, err := f.runtime.Create(ctx, docker.Spec{Name: "liteide-pod-" + strconv.Itoa(record.ID)}); err != nil {
+			t.Fatalf("create instance: %v", err)
+		}
+	}
+	return record
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name        string
+		prepare     func(t *testing.T, f fixture) *ent.Container
+		status      property.ContainerStatus
+		message     string
+		instanceSet bool // 对账后记录是否仍保留实例 ID
+	}{
+		{name: "up and running", prepare: func(t *testing.T, f fixture) *ent.Container {
+			return createUpContainer(t, f)
+		}, status: property.ContainerStatusUp, instanceSet: true},
+		{name: "up but crashed", prepare: func(t *testing.T, f fixture) *ent.Container {
+			record := createUpContainer(t, f)
+			f.runtime.Stop(*record.ContainerID, "exit code 137")
+			return record
+		}, status: property.ContainerStatusError, message: "exit code 137"},
+		{name: "up but restarting", prepare: func(t *testing.T, f fixture) *ent.Container {
+			record := createUpContainer(t, f)
+			f.runtime.SetState(*record.ContainerID, docker.State{State: "restarting"})
+			return record
+		}, status: property.ContainerStatusUp, instanceSet: true},
+		{name: "swarm task preparing", prepare: func(t *testing.T, f fixture) *ent.Container {
+			record := createUpContainer(t, f)
+			f.runtime.SetState(*record.ContainerID, docker.State{State: "preparing"})
+			return record
+		}, status: property.ContainerStatusUp, instanceSet: true},
+		{name: "swarm task rejected", prepare: func(t *testing.T, f fixture) *ent.Container {
+			record := createUpContainer(t, f)
+			f.runtime.SetState(*record.ContainerID, docker.State{State: "rejected"})
+			return record
+		}, status: property.ContainerStatusError, message: "instance rejected"},
+		{name: "up but removed", prepare: func(t *testing.T, f fixture) *ent.Container {
+			record := createUpContainer(t, f)
+			_ = f.runtime.Remove(context.Background(), *record.ContainerID)
+			return record
+		}, status: property.ContainerStatusRemoved, message: "instance removed out-of-band"},
+		{name: "stale pending without instance", prepare: func(t *testing.T, f fixture) *ent.Container {
+			return createPendingContainer(t, f, false)
+		}, status: property.ContainerStatusError, message: "container creation was interrupted"},
+		{name: "stale pending with running instance", prepare: func(t *testing.T, f fixture) *ent.Container {
+			return createPendingContainer(t, f, true)
+		}, status: property.ContainerStatusUp, instanceSet: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			ctx := context.Background()
+			record := tt.prepare(t, f)
+
+			if err := f.containers.Reconcile(ctx); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+
+			record = f.containers.database.Container.GetX(ctx, record.ID)
+			if record.ContainerStatus != tt.status {
+				t.Errorf("status = %s, want %s", record.ContainerStatus, tt.status)
+			}
+			if tt.message != "" && (record.StatusMessage == nil || *record.StatusMessage != tt.message) {
+				t.Errorf("status message = %v, want %q", record.StatusMessage, tt.message)
+			}
+			// 不再运行的容器不再跟踪活动
+			f.containers.activity.mu.Lock()
+			_, tracked := f.containers.activity.lastActive[record.ID]
+			f.containers.activity.mu.Unlock()
+			if tracked && record.ContainerStatus != property.ContainerStatusUp {
+				t.Errorf("activity of %s container is still tracked", record.ContainerStatus)
+			}
+			if (record.ContainerID != nil) != tt.instanceSet {
+				t.Errorf("container id = %v, want set %v", record.ContainerID, tt.instanceSet)
+			}
+
+			// 终止状态的记录不再对应任何实例
+			instances, _ := f.runtime.List(ctx, "liteide-pod-")
+			if want := map[bool]int{true: 1, false: 0}[tt.instanceSet]; len(instances) != want {
+				t.Errorf("runtime has %d instances, want %d", len(instances), want)
+			}
+		})
+	}
+
+	t.Run("orphan instance", func(t *testing.T) {
+		f := setupService(t)
+		ctx := context.Background()
+		for _, name := range []string{"liteide-pod-999", "liteide-pod-x", "other-1"} {
+			if _, err := f.runtime.Create(ctx, docker.Spec{Name: name}); err != nil {
+				t.Fatalf("create instance %s: %v", name, err)
+			}
+		}
+
+		if err := f.containers.Reconcile(ctx); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+
+		// 只删除带前缀且能解析出记录 ID 的实例
+		instances, _ := f.runtime.List(ctx, "")
+		names := make(map[string]bool)
+		for _, instance := range instances {
+			names[instance.Name] = true
+		}
+		if names["liteide-pod-999"] || !names["liteide-pod-x"] || !names["other-1"] {
+			t.Errorf("instances after Reconcile() = %v", names)
+		}
+	})
+}
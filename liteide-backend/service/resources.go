@@ -0,0 +1,35 @@
+package service
+
+import (
+	"fmt"
+	"liteide-backend/ent"
+	"liteide-backend/repository/docker"
+)
+
+// containerResources 计算容器的资源限制与预留
+// - `imageInstance`：容器使用的镜像，其资源字段优先于全局默认值
+// - 预留超过上限时按上限截断，上限不合法时返回 ErrInvalidResources
+func (s *ContainerService) containerResources(imageInstance *ent.Image) (docker.Resources, error) {
+	defaults := s.conf.ResourceConfig
+
+	resources := docker.Resources{
+		NanoCPUs:    valueOr(imageInstance.NanoCpus, defaults.NanoCPUs),
+		MemoryBytes: valueOr(imageInstance.MemoryBytes, defaults.MemoryBytes),
+		PidsLimit:   valueOr(imageInstance.PidsLimit, defaults.PidsLimit),
+	}
+	if resources.NanoCPUs <= 0 || resources.MemoryBytes <= 0 || resources.PidsLimit <= 0 {
+		return docker.Resources{}, fmt.Errorf("%w: image %q has non-positive limits", ErrInvalidResources, imageInstance.ImageName)
+	}
+
+	resources.NanoCPUReservation = min(defaults.NanoCPUReservation, resources.NanoCPUs)
+	resources.MemoryReservation = min(defaults.MemoryReservation, resources.MemoryBytes)
+	return resources, nil
+}
+
+// valueOr 返回可空字段的值，为空时返回默认值
+func valueOr[T any](value *T, defaultValue T) T {
+	if value == nil {
+		return defaultValue
+	}
+	return *value
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"liteide-backend/config"
+	"liteide-backend/ent"
+	"liteide-backend/repository/docker"
+	"testing"
+)
+
+func TestContainerResources(t *testing.T) {
+	ptr := func(v int64) *int64 { return &v }
+	defaults := config.ResourceConfig{
+		NanoCPUs:           1e9,
+		MemoryBytes:        1 << 30,
+		PidsLimit:          64,
+		NanoCPUReservation: 5e8,
+		MemoryReservation:  256 << 20,
+	}
+	tests := []struct {
+		name  string
+		image ent.Image
+		want  docker.Resources
+		err   error
+	}{
+		{name: "defaults", image: ent.Image{}, want: docker.Resources{
+			NanoCPUs: 1e9, MemoryBytes: 1 << 30, PidsLimit: 64, NanoCPUReservation: 5e8, MemoryReservation: 256 << 20,
+		}},
+		{name: "image limits", image: ent.Image{NanoCpus: ptr(2e9), MemoryBytes: ptr(2 << 30), PidsLimit: ptr(128)}, want: docker.Resources{
+			NanoCPUs: 2e9, MemoryBytes: 2 << 30, PidsLimit: 128, NanoCPUReservation: 5e8, MemoryReservation: 256 << 20,
+		}},
+		// 镜像上限低于默认预留时，预留按上限截断
+		{name: "reservation capped", image: ent.Image{NanoCpus: ptr(2e8), MemoryBytes: ptr(128 << 20)}, want: docker.Resources{
+			NanoCPUs: 2e8, MemoryBytes: 128 << 20, PidsLimit: 64, NanoCPUReservation: 2e8, MemoryReservation: 128 << 20,
+		}},
+		{name: "zero cpus", image: ent.Image{NanoCpus: ptr(0)}, err: ErrInvalidResources},
+		{name: "negative memory", image: ent.Image{MemoryBytes: ptr(-1)}, err: ErrInvalidResources},
+		{name: "negative pids", image: ent.Image{PidsLimit: ptr(-64)}, err: ErrInvalidResources},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			f.containers.conf.ResourceConfig = defaults
+
+			got, err := f.containers.containerResources(&tt.image)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("containerResources() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("containerResources() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateContainerResources(t *testing.T) {
+	ptr := func(v int64) *int64 { return &v }
+	tests := []struct {
+		name  string
+		input ImageInput // 创建容器前对镜像的修改
+		want  docker.Resources
+		valid bool // 镜像修改是否通过校验
+	}{
+		{name: "defaults", want: docker.Resources{NanoCPUs: 1e9, MemoryBytes: 1 << 30, PidsLimit: 64}, valid: true},
+		{name: "image limits", input: ImageInput{NanoCPUs: ptr(5e8), PidsLimit: ptr(32)},
+			want: docker.Resources{NanoCPUs: 5e8, MemoryBytes: 1 << 30, PidsLimit: 32}, valid: true},
+		// 资源字段为 0 表示恢复默认值
+		{name: "cleared limits", input: ImageInput{MemoryBytes: ptr(0)},
+			want: docker.Resources{NanoCPUs: 1e9, MemoryBytes: 1 << 30, PidsLimit: 64}, valid: true},
+		{name: "negative cpus", input: ImageInput{NanoCPUs: ptr(-1)}},
+		{name: "negative memory", input: ImageInput{MemoryBytes: ptr(-1)}},
+		{name: "negative pids", input: ImageInput{PidsLimit: ptr(-1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			ctx := context.Background()
+			imageId := f.container.QueryImage().OnlyIDX(ctx)
+
+			_, err := f.images.UpdateImage(ctx, imageId, tt.input)
+			if !tt.valid {
+				if !ent.IsValidationError(err) {
+					t.Fatalf("UpdateImage() error = %v, want validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateImage() error = %v", err)
+			}
+
+			record := createUpContainer(t, f)
+			spec, ok := f.runtime.Spec(*record.ContainerID)
+			if !ok {
+				t.Fatalf("instance %s not found", *record.ContainerID)
+			}
+			if spec.Resources != tt.want {
+				t.Errorf("resources = %+v, want %+v", spec.Resources, tt.want)
+			}
+		})
+	}
+}
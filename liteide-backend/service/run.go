@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/google/uuid"
+	"io"
+	"liteide-backend/ent"
+	"liteide-backend/repository/docker"
+	"liteide-backend/repository/model"
+	"strconv"
+	"time"
+)
+
+// runKillGrace 容器内 timeout 命令之外，服务端额外等待的时间
+const runKillGrace = 5 * time.Second
+
+// runOutputPrefix 容器内每次运行的输出目录前缀，目录名后附加随机 ID
+const runOutputPrefix = "/tmp/liteide-run-"
+
+// RunResult 程序运行结果
+type RunResult struct {
+	ExitCode int           // 进程退出码
+	Duration time.Duration // 运行耗时（包含编译）
+	TimedOut bool          // 是否因超时被终止
+}
+
+// RunContainer 在容器内非交互地编译并运行工作区程序
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `containerId`：容器 ID
+// - `entry`：入口文件路径，为空时使用语言的默认入口文件
+// - `timeout`：运行超时时间，为 0 时使用配置的默认值，不能超过配置的上限
+// - `stdout`、`stderr`：程序的标准输出与标准错误
+func (s *ContainerService) RunContainer(ctx context.Context, user *ent.User, containerId int, entry string, timeout time.Duration, stdout io.Writer, stderr io.Writer) (*RunResult, error) {
+	// 校验超时时间
+	if timeout == 0 {
+		timeout = s.conf.RunTimeout
+	}
+	if timeout < time.Second || timeout > s.conf.RunMaxTimeout {
+		return nil, fmt.Errorf("%w: timeout must be between 1s and %v", ErrInvalidRun, s.conf.RunMaxTimeout)
+	}
+
+	// 根据工作区语言确定编译运行命令
+	containerInstance, err := s.getContainer(ctx, user, containerId)
+	if err != nil {
+		return nil, err
+	}
+	workspaceInstance, err := containerInstance.QueryWorkspace().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chain, ok := s.languages.Lookup(workspaceInstance.Language)
+	if !ok {
+		return nil, fmt.Errorf("%w: language %s cannot be run", ErrInvalidRun, workspaceInstance.Language)
+	}
+	if entry == "" {
+		entry = chain.DefaultEntry
+	}
+	entry, err = cleanWorkspaceEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查找运行中的容器实例
+	instanceId, err := s.runningInstance(ctx, containerId)
+	if err != nil {
+		return nil, err
+	}
+	s.TouchContainer(containerId)
+
+	// 容器内使用 timeout 强制终止，服务端额外等待一段时间后断开
+	ctx, cancel := context.WithTimeout(ctx, timeout+runKillGrace)
+	defer cancel()
+
+	execId, err := s.runtime.ExecCreate(ctx, instanceId, docker.ExecConfig{
+		WorkingDir: "/workspace",
+		Cmd: []string{
+			"timeout", "-s", "KILL", strconv.Itoa(int(timeout.Seconds())),
+			"sh", "-c", runScript(chain, entry, runOutputPrefix+uuid.NewString()),
+		},
+	})
+	if err != nil {
+		return nil, runtimeError(err)
+	}
+
+	start := time.Now()
+	conn, err := s.runtime.ExecAttach(ctx, execId, false)
+	if err != nil {
+		return nil, runtimeError(err)
+	}
+	defer conn.Close()
+
+	// 上下文结束时断开连接，使输出拷贝立即返回
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	// 非 TTY 模式下输出为多路复用流，需要拆分标准输出与标准错误
+	_, copyErr := stdcopy.StdCopy(stdout, stderr, conn.Reader)
+	result := &RunResult{Duration: time.Since(start)}
+
+	// 查询退出码（运行上下文可能已超时，使用新的上下文）
+	inspectCtx, inspectCancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer inspectCancel()
+	status, err := s.runtime.ExecInspect(inspectCtx, execId)
+	if err != nil {
+		return nil, runtimeError(err)
+	}
+	if status.Running {
+		// 服务端等待超时，进程仍未退出
+		result.TimedOut = true
+		result.ExitCode = -1
+		return result, nil
+	}
+	if copyErr != nil && ctx.Err() == nil {
+		return nil, copyErr
+	}
+
+	// timeout -s KILL 终止进程时退出码为 128+9
+	result.ExitCode = status.ExitCode
+	result.TimedOut = status.ExitCode == 137 && result.Duration >= timeout
+	return result, nil
+}
+
+// runScript 生成在独占输出目录中编译运行入口文件的脚本
+// - 运行前创建输出目录，结束后删除该目录，并以编译运行命令的退出码退出
+// - 被 timeout 强制终止时来不及清理，目录留在容器的 /tmp 中
+func runScript(chain model.Toolchain, entry string, output string) string {
+	return fmt.Sprintf("mkdir -p %[1]s && (%[2]s); status=$?; rm -rf %[1]s; exit $status", output, chain.Command(entry, output))
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"liteide-backend/repository/model"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunContainerConcurrent(t *testing.T) {
+	// 内存运行时在本机执行命令，运行脚本依赖 sh 与 timeout
+	for _, command := range []string{"sh", "timeout"} {
+		if _, err := exec.LookPath(command); err != nil {
+			t.Skipf("%s is not available: %v", command, err)
+		}
+	}
+
+	f := setupService(t)
+	ctx := context.Background()
+	record := createUpContainer(t, f)
+
+	// 只依赖 shell 的工具链：“编译”将入口文件复制到输出目录，等待片刻使两次运行的编译与运行交错
+	languages, err := model.NewRegistry([]model.Toolchain{{
+		Name:           "C",
+		DisplayName:    "C",
+		Image:          "gcc:13",
+		CompileCommand: "cp {entry} {output}/main && sleep 0.2",
+		RunCommand:     "sh {output}/main",
+		DefaultEntry:   "main.sh",
+	}})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	f.containers.languages = languages
+
+	// 两个入口文件输出不同内容，并发运行时各自的产物互不覆盖
+	entries := []string{"a.sh", "b.sh"}
+	for _, entry := range entries {
+		if err := f.workspaces.WriteFile(ctx, f.alice, f.workspace.ID, entry, []byte(fmt.Sprintf("echo %s\n", entry))); err != nil {
+			t.Fatalf("WriteFile(%s) error = %v", entry, err)
+		}
+	}
+
+	before, _ := filepath.Glob(runOutputPrefix + "*")
+	var wg sync.WaitGroup
+	for _, entry := range entries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var stdout, stderr bytes.Buffer
+			result, err := f.containers.RunContainer(ctx, f.alice, record.ID, entry, 10*time.Second, &stdout, &stderr)
+			if err != nil {
+				t.Errorf("RunContainer(%s) error = %v", entry, err)
+				return
+			}
+			if result.ExitCode != 0 {
+				t.Errorf("RunContainer(%s) exit code = %d, stderr = %q", entry, result.ExitCode, stderr.String())
+			}
+			if got := stdout.String(); got != entry+"\n" {
+				t.Errorf("RunContainer(%s) stdout = %q, want %q", entry, got, entry+"\n")
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 运行结束后删除输出目录
+	if after, _ := filepath.Glob(runOutputPrefix + "*"); len(after) != len(before) {
+		t.Errorf("output directories = %v, want %v", after, before)
+	}
+}
@@ -0,0 +1,23 @@
+package service
+
+import "liteide-backend/svc"
+
+// Services 应用的全部服务
+// - 由同一个服务上下文创建，路由与后台协程共享同一组服务（活动跟踪、配额锁与拉取队列都保存在服务内）
+type Services struct {
+	Auth       *AuthService      // 认证服务
+	Workspaces *WorkspaceService // 工作区服务
+	Containers *ContainerService // 容器服务
+	Images     *ImageService     // 镜像服务
+}
+
+// NewServices 使用服务上下文创建全部服务
+func NewServices(sc *svc.ServiceContext) *Services {
+	workspaces := NewWorkspaceService(sc)
+	return &Services{
+		Auth:       NewAuthService(sc),
+		Workspaces: workspaces,
+		Containers: NewContainerService(sc, workspaces),
+		Images:     NewImageService(sc),
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"github.com/fsnotify/fsnotify"
+	"github.com/gofiber/fiber/v2/log"
+	"io/fs"
+	"liteide-backend/ent"
+	"path/filepath"
+	"time"
+)
+
+// watchDebounce 合并文件事件的时间窗口（编辑器保存时往往连续触发多次写入）
+const watchDebounce = 200 * time.Millisecond
+
+// watchMaxLatency 批次中第一个事件的最长等待时间，持续写入（例如编译输出）时也按该间隔发送
+const watchMaxLatency = time.Second
+
+// watchMaxBatch 单个批次的最大路径数，达到后立即发送
+const watchMaxBatch = 1000
+
+// 文件事件类型
+const (
+	FileEventCreate = "create" // 新建文件或目录（包括移入）
+	FileEventModify = "modify" // 文件内容或属性变化
+	FileEventDelete = "delete" // 删除文件或目录
+	FileEventRename = "rename" // 文件或目录被重命名或移出（新路径以 create 事件报告）
+)
+
+// FileEvent 工作区内的文件变更事件
+type FileEvent struct {
+	Type string // 事件类型
+	Path string // 相对工作区根目录的路径，使用 / 分隔
+}
+
+// WatchWorkspace 递归监听工作区目录的文件变更
+// - `ctx`：控制监听生命周期的上下文，取消后停止监听并关闭通道
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - 返回按时间窗口合并后的事件批次，同一路径在同一批次内只保留最后一次事件
+// - 事件停止 watchDebounce 后发送批次；持续有事件时最迟在 watchMaxLatency 后或达到 watchMaxBatch 时发送
+func (s *WorkspaceService) WatchWorkspace(ctx context.Context, user *ent.User, workspaceId int) (<-chan []FileEvent, error) {
+	workspaceInstance, err := s.getWorkspace(ctx, user, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Clean(s.WorkspaceDirectory(workspaceInstance))
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := watchTree(watcher, dir); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	events := make(chan []FileEvent)
+	go func() {
+		defer close(events)
+		defer watcher.Close()
+
+		pending := make(map[string]string) // 路径 -> 事件类型
+		var order []string                 // 保持事件的先后顺序
+		var first time.Time                // 当前批次第一个事件的时间
+		timer := time.NewTimer(watchDebounce)
+		timer.Stop()
+
+		// flush 发送当前批次，上下文取消时返回 false
+		flush := func() bool {
+			timer.Stop()
+			batch := make([]FileEvent, 0, len(order))
+			for _, path := range order {
+				batch = append(batch, FileEvent{Type: pending[path], Path: path})
+			}
+			clear(pending)
+			order = order[:0]
+
+			select {
+			case events <- batch:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warnf("workspace %d watcher error: %v", workspaceId, err)
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				rel, err := filepath.Rel(dir, event.Name)
+				if err != nil || rel == "." {
+					continue
+				}
+
+				eventType := fileEventType(event)
+				if eventType == "" {
+					continue
+				}
+				// 新建目录时递归添加监听
+				if eventType == FileEventCreate {
+					if err := watchTree(watcher, event.Name); err != nil {
+						log.Debugf("failed to watch %s: %v", event.Name, err)
+					}
+				}
+
+				path := filepath.ToSlash(rel)
+				if len(order) == 0 {
+					first = time.Now()
+				}
+				if _, exists := pending[path]; !exists {
+					order = append(order, path)
+				}
+				pending[path] = mergeFileEvent(pending[path], eventType)
+
+				if len(order) >= watchMaxBatch {
+					if !flush() {
+						return
+					}
+					continue
+				}
+				// 等待 watchDebounce 的静默期，但不超过第一个事件的最长等待时间
+				timer.Reset(min(watchDebounce, time.Until(first.Add(watchMaxLatency))))
+			case <-timer.C:
+				if !flush() {
+					return
+				}
+			}
+		}
+	}()
+	return events, nil
+}
+
+// watchTree 为目录及其所有子目录添加监听（不跟随符号链接）
+func watchTree(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// 目录在遍历过程中被删除
+			if path != root {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		return watcher.Add(path)
+	})
+}
+
+// fileEventType 将 fsnotify 事件转换为文件事件类型，忽略的事件返回空字符串
+func fileEventType(event fsnotify.Event) string {
+	switch {
+	case event.Has(fsnotify.Create):
+		return FileEventCreate
+	case event.Has(fsnotify.Remove):
+		return FileEventDelete
+	case event.Has(fsnotify.Rename):
+		return FileEventRename
+	case event.Has(fsnotify.Write), event.Has(fsnotify.Chmod):
+		return FileEventModify
+	default:
+		return ""
+	}
+}
+
+// mergeFileEvent 合并同一路径在同一时间窗口内的事件
+// - 新建后修改仍报告为新建；其余情况以最后一次事件为准
+func mergeFileEvent(previous string, next string) string {
+	if previous == FileEventCreate && next == FileEventModify {
+		return FileEventCreate
+	}
+	return next
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMergeFileEvent(t *testing.T) {
+	tests := []struct {
+		previous, next, want string
+	}{
+		{previous: "", next: FileEventCreate, want: FileEventCreate},
+		{previous: FileEventCreate, next: FileEventModify, want: FileEventCreate},
+		{previous: FileEventCreate, next: FileEventDelete, want: FileEventDelete},
+		{previous: FileEventModify, next: FileEventModify, want: FileEventModify},
+		{previous: FileEventDelete, next: FileEventCreate, want: FileEventCreate},
+		{previous: FileEventCreate, next: FileEventRename, want: FileEventRename},
+	}
+	for _, tt := range tests {
+		if got := mergeFileEvent(tt.previous, tt.next); got != tt.want {
+			t.Errorf("mergeFileEvent(%q, %q) = %q, want %q", tt.previous, tt.next, got, tt.want)
+		}
+	}
+}
+
+// startWatch 监听 alice 的工作区，返回事件通道与工作区目录
+func startWatch(t *testing.T, f fixture) (<-chan []FileEvent, string) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	events, err := f.workspaces.WatchWorkspace(ctx, f.alice, f.workspace.ID)
+	if err != nil {
+		t.Fatalf("WatchWorkspace() error = %v", err)
+	}
+	return events, f.workspaces.WorkspaceDirectory(f.workspace)
+}
+
+func TestWatchWorkspace(t *testing.T) {
+	f := setupService(t)
+	dir := f.workspaces.WorkspaceDirectory(f.workspace)
+	if err := os.WriteFile(filepath.Join(dir, "old.txt"), []byte("old"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	events, dir := startWatch(t, f)
+
+	// 新建并写入、重命名、删除
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "new.txt"), []byte("new"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "old.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"new.txt": FileEventCreate, // 新建后写入仍报告为新建
+		"a.txt":   FileEventRename,
+		"b.txt":   FileEventCreate,
+		"old.txt": FileEventDelete,
+	}
+	got := make(map[string]string)
+	timeout := time.After(5 * time.Second)
+	for len(got) < len(want) {
+		select {
+		case batch := <-events:
+			for _, event := range batch {
+				got[event.Path] = mergeFileEvent(got[event.Path], event.Type)
+			}
+		case <-timeout:
+			t.Fatalf("events = %v, want %v", got, want)
+		}
+	}
+	for path, eventType := range want {
+		if got[path] != eventType {
+			t.Errorf("event of %s = %q, want %q", path, got[path], eventType)
+		}
+	}
+}
+
+func TestWatchWorkspaceMaxLatency(t *testing.T) {
+	f := setupService(t)
+	events, dir := startWatch(t, f)
+
+	// 持续写入时事件不会一直处于静默等待中
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	go func() {
+		ticker := time.NewTicker(watchDebounce / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = os.WriteFile(filepath.Join(dir, "build.log"), []byte(time.Now().String()), 0o644)
+			}
+		}
+	}()
+
+	start := time.Now()
+	select {
+	case batch := <-events:
+		if len(batch) == 0 || batch[0].Path != "build.log" {
+			t.Errorf("batch = %v, want build.log", batch)
+		}
+		if elapsed := time.Since(start); elapsed > watchMaxLatency+watchDebounce*2 {
+			t.Errorf("first batch after %v, want within %v", elapsed, watchMaxLatency)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no batch while files are written continuously")
+	}
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+	"liteide-backend/config"
+	"liteide-backend/ent"
+	"liteide-backend/ent/container"
+	"liteide-backend/ent/property"
+	"liteide-backend/ent/workspace"
+	"liteide-backend/repository/db"
+	"liteide-backend/repository/model"
+	"liteide-backend/svc"
+	"os"
+	"path"
+	"sync"
+)
+
+// WorkspaceService 工作区、工作区文件与用户配额
+type WorkspaceService struct {
+	database  *ent.Client      // 数据库客户端
+	conf      config.AppConfig // 应用配置
+	languages *model.Registry  // 语言工具链注册表
+	quotaMu   sync.Mutex       // 串行化“检查配额 + 创建记录”，避免并发请求超出容器数与工作区数配额
+}
+
+// NewWorkspaceService 使用服务上下文创建工作区服务
+func NewWorkspaceService(sc *svc.ServiceContext) *WorkspaceService {
+	return &WorkspaceService{database: sc.Database, conf: sc.AppConfig, languages: sc.Languages}
+}
+
+// WorkspaceDirectory 返回工作区在宿主机上的目录
+// - 目录为 DataDirectory/workspace/<uuid>，会被绑定挂载到容器的 /workspace
+func (s *WorkspaceService) WorkspaceDirectory(workspaceInstance *ent.Workspace) string {
+	return path.Join(s.conf.DataDirectory, "workspace", workspaceInstance.UUID.String())
+}
+
+// CreateWorkspace 创建工作区记录及其目录
+// - `ctx`：请求的上下文
+// - `user`：当前用户，工作区属于该用户
+// - `name`：工作区名称
+// - `language`：工作区使用的编程语言，必须存在于语言工具链注册表
+// - `networkMode`：网络隔离策略，为 nil 时使用镜像配置
+// - 超出工作区数配额时返回 ErrQuotaExceeded
+// - 目录创建失败时回滚数据库记录，事务提交失败时删除已创建的目录
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, user *ent.User, name string, language property.Language, networkMode *property.NetworkMode) (*ent.Workspace, error) {
+	// 只能使用注册表中的语言
+	if _, ok := s.languages.Lookup(language); !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownLanguage, language)
+	}
+
+	var created *ent.Workspace
+	err := s.withQuotaLock(func() error {
+		if err := s.checkWorkspaceQuota(ctx, user.ID); err != nil {
+			return err
+		}
+		return db.WithTx(s.database, ctx, func(client *ent.Client, ctx context.Context) error {
+			var err error
+			created, err = client.Workspace.Create().
+				SetUserID(user.ID).
+				SetName(name).
+				SetLanguage(language).
+				SetNillableNetworkMode(networkMode).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+
+			// 确保父目录存在，然后创建工作区目录（已存在则视为错误）
+			dir := s.WorkspaceDirectory(created)
+			if err := os.MkdirAll(path.Dir(dir), 0o755); err != nil {
+				return err
+			}
+			return os.Mkdir(dir, 0o755)
+		})
+	})
+	if err != nil {
+		// 目录已创建但事务提交失败时，清理目录
+		if created != nil {
+			if err := os.Remove(s.WorkspaceDirectory(created)); err != nil && !os.IsNotExist(err) {
+				log.Errorf("failed to clean up workspace directory: %v", err)
+			}
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListWorkspaces 分页查询工作区
+// - `ctx`：请求的上下文
+// - `user`：当前用户，管理员可以查询所有工作区，其他用户只能查询自己的工作区
+// - `offset`：分页偏移量
+// - `limit`：分页大小
+// - 返回当前页的工作区和工作区总数
+func (s *WorkspaceService) ListWorkspaces(ctx context.Context, user *ent.User, offset int, limit int) ([]*ent.Workspace, int, error) {
+	query, err := visibleWorkspaces(s.database, user)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	workspaces, err := query.
+		Order(ent.Desc(workspace.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return workspaces, total, nil
+}
+
+// GetWorkspace 查询单个工作区
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+func (s *WorkspaceService) GetWorkspace(ctx context.Context, user *ent.User, workspaceId int) (*ent.Workspace, error) {
+	return s.getWorkspace(ctx, user, workspaceId)
+}
+
+// RenameWorkspace 重命名工作区（目录以 UUID 命名，无需移动）
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - `name`：新的工作区名称
+func (s *WorkspaceService) RenameWorkspace(ctx context.Context, user *ent.User, workspaceId int, name string) (*ent.Workspace, error) {
+	workspaceInstance, err := s.getWorkspace(ctx, user, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return workspaceInstance.Update().
+		SetName(name).
+		Save(ctx)
+}
+
+// DeleteWorkspace 删除工作区记录及其目录
+// - `ctx`：请求的上下文
+// - `user`：当前用户
+// - `workspaceId`：工作区 ID
+// - 仍有 Pending 或 Up 容器时返回 ErrWorkspaceInUse
+// - 事务内先将目录移动到唯一的临时位置，再删除数据库记录；提交成功后删除目录，失败时移回原位
+func (s *WorkspaceService) DeleteWorkspace(ctx context.Context, user *ent.User, workspaceId int) error {
+	var dir, trash string
+	err := db.WithTx(s.database, ctx, func(client *ent.Client, ctx context.Context) error {
+		query, err := visibleWorkspaces(client, user)
+		if err != nil {
+			return err
+		}
+		workspaceInstance, err := query.Where(workspace.ID(workspaceId)).Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		// 正在使用的工作区不能删除
+		active, err := workspaceInstance.QueryContainers().
+			Where(container.ContainerStatusIn(property.ContainerStatusPending, property.ContainerStatusUp)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if active {
+			return ErrWorkspaceInUse
+		}
+
+		// 将目录移动到临时位置（同一文件系统内的原子操作）
+		// - 临时目录名唯一，之前中断的删除遗留的临时目录不会导致重命名失败
+		dir = s.WorkspaceDirectory(workspaceInstance)
+		trash = fmt.Sprintf("%s.deleted-%s", dir, uuid.NewString())
+		if err := os.Rename(dir, trash); err != nil {
+			if !os.IsNotExist(err) {
+				return err
+			}
+			trash = "" // 目录不存在，无需移动
+		}
+
+		// 删除历史容器记录，然后删除工作区记录
+		if _, err := client.Container.Delete().
+			Where(container.HasWorkspaceWith(workspace.ID(workspaceId))).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return client.Workspace.DeleteOne(workspaceInstance).Exec(ctx)
+	})
+	if err != nil {
+		// 目录已移动但删除记录或提交事务失败时，将目录移回原位
+		if trash != "" {
+			if _, statErr := os.Stat(trash); statErr == nil {
+				if err := os.Rename(trash, dir); err != nil {
+					log.Errorf("failed to restore workspace directory: %v", err)
+				}
+			}
+		}
+		return err
+	}
+
+	// 事务已提交，删除目录
+	if trash != "" {
+		if err := os.RemoveAll(trash); err != nil {
+			log.Errorf("failed to remove workspace directory: %v", err)
+		}
+	}
+	return nil
+}
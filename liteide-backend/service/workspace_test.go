@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"liteide-backend/ent"
+	"liteide-backend/ent/hook"
+	"liteide-backend/ent/workspace"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCreateWorkspace(t *testing.T) {
+	f := setupService(t)
+	ctx := context.Background()
+
+	created, err := f.workspaces.CreateWorkspace(ctx, f.bob, "bob's", "PYTHON", nil)
+	if err != nil {
+		t.Fatalf("CreateWorkspace() error = %v", err)
+	}
+	if created.UserID != f.bob.ID {
+		t.Errorf("owner = %d, want %d", created.UserID, f.bob.ID)
+	}
+	if info, err := os.Stat(f.workspaces.WorkspaceDirectory(created)); err != nil || !info.IsDir() {
+		t.Errorf("workspace directory = %v, %v, want a directory", info, err)
+	}
+
+	if _, err := f.workspaces.CreateWorkspace(ctx, f.bob, "cobol", "COBOL", nil); !errors.Is(err, ErrUnknownLanguage) {
+		t.Errorf("CreateWorkspace(COBOL) error = %v, want %v", err, ErrUnknownLanguage)
+	}
+}
+
+func TestRenameWorkspace(t *testing.T) {
+	f := setupService(t)
+	ctx := context.Background()
+
+	renamed, err := f.workspaces.RenameWorkspace(ctx, f.alice, f.workspace.ID, "renamed")
+	if err != nil {
+		t.Fatalf("RenameWorkspace() error = %v", err)
+	}
+	if renamed.Name != "renamed" || renamed.UUID != f.workspace.UUID {
+		t.Errorf("RenameWorkspace() = %s %s, want renamed with the same directory", renamed.Name, renamed.UUID)
+	}
+
+	// 其他用户不能重命名，名称保持不变
+	_, err = f.workspaces.RenameWorkspace(ctx, f.bob, f.workspace.ID, "stolen")
+	checkErr(t, err, errNotFound)
+	if got := f.workspaces.database.Workspace.GetX(ctx, f.workspace.ID).Name; got != "renamed" {
+		t.Errorf("name after rename by other user = %q, want %q", got, "renamed")
+	}
+}
+
+func TestDeleteWorkspace(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, f fixture) // 删除前的准备工作
+		user    func(f fixture) *ent.User
+		want    error
+	}{
+		{name: "owner", user: func(f fixture) *ent.User { return f.alice }},
+		{name: "admin", user: func(f fixture) *ent.User { return f.admin }},
+		{name: "other user", user: func(f fixture) *ent.User { return f.bob }, want: errNotFound},
+		{name: "in use", prepare: func(t *testing.T, f fixture) {
+			createUpContainer(t, f)
+		}, user: func(f fixture) *ent.User { return f.alice }, want: ErrWorkspaceInUse},
+		{name: "stale trash", prepare: func(t *testing.T, f fixture) {
+			// 之前中断的删除遗留的临时目录
+			dir := f.workspaces.WorkspaceDirectory(f.workspace)
+			if err := os.Mkdir(dir+".deleted", 0o755); err != nil {
+				t.Fatal(err)
+			}
+		}, user: func(f fixture) *ent.User { return f.alice }},
+		{name: "database fails", prepare: func(t *testing.T, f fixture) {
+			f.workspaces.database.Workspace.Use(hook.On(func(ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+					return nil, errBoom
+				})
+			}, ent.OpDeleteOne))
+		}, user: func(f fixture) *ent.User { return f.alice }, want: errBoom},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setupService(t)
+			ctx := context.Background()
+			dir := f.workspaces.WorkspaceDirectory(f.workspace)
+			if err := os.WriteFile(filepath.Join(dir, "main.c"), []byte("int main() {}"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if tt.prepare != nil {
+				tt.prepare(t, f)
+			}
+
+			err := f.workspaces.DeleteWorkspace(ctx, tt.user(f), f.workspace.ID)
+			checkErr(t, err, tt.want)
+
+			exists := f.workspaces.database.Workspace.Query().Where(workspace.ID(f.workspace.ID)).ExistX(ctx)
+			_, statErr := os.Stat(filepath.Join(dir, "main.c"))
+			// 本次移动的临时目录已删除或已移回原位
+			if trash, _ := filepath.Glob(dir + ".deleted-*"); len(trash) != 0 {
+				t.Errorf("trash directories left: %v", trash)
+			}
+			if tt.want == nil {
+				if exists || !errors.Is(statErr, os.ErrNotExist) {
+					t.Errorf("after delete: record exists = %v, directory stat = %v", exists, statErr)
+				}
+				return
+			}
+			// 删除失败时记录与目录均保持原样
+			if !exists || statErr != nil {
+				t.Errorf("after failed delete: record exists = %v, directory stat = %v", exists, statErr)
+			}
+		})
+	}
+}
@@ -1,32 +1,87 @@
 package svc
 
 import (
-	dockerClient "github.com/docker/docker/client" // 引入 Docker 客户端库，用于与 Docker 进行交互
-	"liteide-backend/config"                       // 引入配置管理包，用于加载应用配置
-	"liteide-backend/ent"                          // 引入 ent ORM 库，用于与数据库交互
-	"liteide-backend/repository/db"                // 引入数据库操作包，包含数据库初始化和迁移等功能
-	"liteide-backend/repository/docker"            // 引入 Docker 操作包，提供与 Docker 客户端交互的功能
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/gofiber/fiber/v2/log"   // 引入 Fiber 的日志库
+	"liteide-backend/config"            // 引入配置管理包，用于加载应用配置
+	"liteide-backend/ent"               // 引入 ent ORM 库，用于与数据库交互
+	"liteide-backend/repository/db"     // 引入数据库操作包，包含数据库初始化和迁移等功能
+	"liteide-backend/repository/docker" // 引入 Docker 操作包，提供与 Docker 客户端交互的功能
+	"liteide-backend/repository/model"  // 引入语言工具链注册表
+	"os"
 )
 
-// 全局变量 SVC，用于存储应用的 ServiceContext 实例
-var SVC *ServiceContext
-
 // ServiceContext 结构体用于存储应用程序所需的所有服务和配置
 type ServiceContext struct {
-	AppConfig config.AppConfig     // 存储应用程序的配置
-	Database  *ent.Client          // 数据库客户端，用于数据库操作
-	Docker    *dockerClient.Client // Docker 客户端，用于与 Docker 交互
+	AppConfig config.AppConfig        // 存储应用程序的配置
+	Database  *ent.Client             // 数据库客户端，用于数据库操作
+	Runtime   docker.ContainerRuntime // 容器运行时，用于创建、删除容器和在容器内执行命令
+	Languages *model.Registry         // 语言工具链注册表
 }
 
-// NewServiceContext 用于初始化 ServiceContext 并将其赋值给全局变量 SVC
-func NewServiceContext() {
+// NewServiceContext 加载配置并初始化 ServiceContext
+// - 配置不合法或依赖初始化失败时终止程序
+func NewServiceContext() *ServiceContext {
 	// 获取应用的配置
 	appConf := config.NewConfig()
 
-	// 初始化 ServiceContext，并将其赋值给全局变量 SVC
-	SVC = &ServiceContext{
-		AppConfig: appConf,                           // 将应用配置赋值给 ServiceContext
-		Database:  db.InitMySQL(appConf.MySQLConfig), // 初始化数据库连接，使用配置中的 MySQL 配置
-		Docker:    docker.InitDocker(),               // 初始化 Docker 客户端
+	// 校验容器资源配置，配置错误时终止程序
+	if err := appConf.ResourceConfig.Validate(); err != nil {
+		log.Fatalf("invalid resource config: %v", err)
+	}
+	// 校验容器网络配置
+	if err := appConf.NetworkConfig.Validate(); err != nil {
+		log.Fatalf("invalid network config: %v", err)
+	}
+
+	// 根据配置创建容器运行时
+	runtime, err := docker.NewRuntime(appConf)
+	if err != nil {
+		log.Fatalf("invalid container runtime: %v", err)
+	}
+
+	// 根据配置打开数据库连接
+	database, err := db.Open(appConf.DatabaseConfig)
+	if err != nil {
+		log.Fatalf("failed opening connection to database: %v", err)
+	}
+
+	// 未配置令牌密钥时随机生成，重启后已签发的令牌全部失效
+	if appConf.AuthConfig.JWTSecret == "" {
+		log.Warn("JWT_SECRET is not set, using a random secret; tokens will not survive restarts")
+		appConf.AuthConfig.JWTSecret = randomSecret()
+	}
+
+	// 初始化 ServiceContext
+	return &ServiceContext{
+		AppConfig: appConf,                             // 将应用配置赋值给 ServiceContext
+		Database:  database,                            // 数据库客户端，驱动由配置选择
+		Runtime:   runtime,                             // 容器运行时
+		Languages: loadLanguages(appConf.LanguageFile), // 加载语言工具链注册表
+	}
+}
+
+// loadLanguages 加载语言工具链注册表
+// - 配置文件不存在时使用内置配置，配置文件不合法时终止程序
+func loadLanguages(file string) *model.Registry {
+	registry, err := model.LoadRegistry(file)
+	if errors.Is(err, os.ErrNotExist) {
+		log.Warnf("language file %s not found, using built-in languages", file)
+		return model.DefaultRegistry()
+	}
+	if err != nil {
+		log.Fatalf("failed to load languages: %v", err)
+	}
+	return registry
+}
+
+// randomSecret 生成 32 字节的随机密钥
+func randomSecret() string {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatalf("failed to generate secret: %v", err)
 	}
+	return hex.EncodeToString(secret)
 }